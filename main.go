@@ -1,16 +1,37 @@
 package main
 
 import (
+	"fmt"
+	"os"
+	"strconv"
+
 	"github.com/boyter/scc/processor"
 	"github.com/spf13/cobra"
 )
 
+// Flag value for a size in bytes which accepts human readable sizes such as 5MB
+type byteSizeValue int64
+
+func (b *byteSizeValue) String() string {
+	return strconv.FormatInt(int64(*b), 10)
+}
+
+func (b *byteSizeValue) Set(value string) error {
+	size, err := processor.ParseByteSize(value)
+	if err != nil {
+		return err
+	}
+
+	*b = byteSizeValue(size)
+	return nil
+}
+
+func (b *byteSizeValue) Type() string {
+	return "size"
+}
+
 //go:generate go run scripts/include.go
 func main() {
-	//f, _ := os.Create("scc.pprof")
-	//pprof.StartCPUProfile(f)
-	//defer pprof.StopCPUProfile()
-
 	rootCmd := &cobra.Command{
 		Use:     "scc",
 		Short:   "scc DIRECTORY",
@@ -18,6 +39,10 @@ func main() {
 		Version: "1.12.1",
 		Run: func(cmd *cobra.Command, args []string) {
 			processor.DirFilePaths = args
+			if err := processor.LoadConfig(cmd.Flags()); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
 			processor.ConfigureGc()
 			processor.Process()
 		},
@@ -25,7 +50,7 @@ func main() {
 
 	flags := rootCmd.PersistentFlags()
 
-	flags.Int64Var(
+	flags.Float64Var(
 		&processor.AverageWage,
 		"avg-wage",
 		56286,
@@ -37,6 +62,12 @@ func main() {
 		false,
 		"disable binary file detection",
 	)
+	flags.IntVar(
+		&processor.ByDirectory,
+		"by-directory",
+		0,
+		"display the sum of the files under each directory this many levels deep [e.g. --by-directory 1]",
+	)
 	flags.BoolVar(
 		&processor.Files,
 		"by-file",
@@ -44,35 +75,192 @@ func main() {
 		"display output for every file",
 	)
 	flags.BoolVar(
-		&processor.Cocomo,
+		&processor.NoCocomo,
 		"cocomo",
 		false,
 		"remove COCOMO calculation output",
 	)
+	flags.MarkDeprecated("cocomo", "use --no-cocomo instead")
+	flags.StringVar(
+		&processor.CocomoProjectType,
+		"cocomo-project-type",
+		"organic",
+		"change COCOMO model type [organic, semi-detached, embedded, \"custom,1,1,1,1\"]",
+	)
+	flags.StringToStringVar(
+		&processor.CocomoWeights,
+		"cocomo-weights",
+		map[string]string{},
+		"multiply the code of a language by the weight for the COCOMO estimates [comma separated list: e.g. Assembly=2,Python=0.8]",
+	)
+	flags.StringVar(
+		&processor.ConfigFile,
+		"config",
+		"",
+		"read flags not set on the command line from this JSON file (default .scc if it exists)",
+	)
+	flags.StringSliceVar(
+		&processor.CountAs,
+		"count-as",
+		[]string{},
+		"count files with the extension as the language, can be repeated [comma separated list: e.g. ts:typescript,es6:javascript]",
+	)
+	flags.BoolVar(
+		&processor.CountUnknown,
+		"count-unknown",
+		false,
+		"count files which cannot be identified as the Unknown language with only code and blank lines rather than skipping them",
+	)
+	flags.BoolVar(
+		&processor.ComplexityHistogram,
+		"complexity-histogram",
+		false,
+		"display how many files of each language have complexity 0, 1-5, 6-20 and 21+",
+	)
+	flags.Int64Var(
+		&processor.ComplexityMax,
+		"complexity-max",
+		0,
+		"exit with an error naming the files with complexity over this once the report is written, 0 for no maximum",
+	)
+	flags.StringArrayVar(
+		&processor.ComplexityTokens,
+		"complexity-token",
+		[]string{},
+		"count the token as complexity in the language (can be repeated) [e.g. --complexity-token C:RETRY(]",
+	)
+	flags.StringVar(
+		&processor.CpuProfile,
+		"cpuprofile",
+		"",
+		"write a cpu profile of the run to the file for use with go tool pprof",
+	)
+	flags.StringVar(
+		&processor.CurrencySymbol,
+		"currency-symbol",
+		"$",
+		"set currency symbol used in COCOMO cost output",
+	)
 	flags.BoolVar(
 		&processor.Debug,
 		"debug",
 		false,
 		"enable debug output",
 	)
+	flags.StringVar(
+		&processor.DebugFile,
+		"debug-file",
+		"",
+		"write each state change made counting the file to stderr instead of the summary [e.g. --debug-file main.go]",
+	)
+	flags.BoolVar(
+		&processor.Diff,
+		"diff",
+		false,
+		"compare two reports written with --format json [e.g. scc --diff old.json new.json]",
+	)
+	flags.BoolVar(
+		&processor.Docstrings,
+		"docstrings",
+		false,
+		"count docstrings in languages such as Python as comments rather than code",
+	)
+	flags.BoolVar(
+		&processor.DryRun,
+		"dry-run",
+		false,
+		"write each file which would be counted and its language without counting them unless a filter such as --no-gen needs their content",
+	)
+	flags.BoolVar(
+		&processor.DuplicateGroups,
+		"duplicate-groups",
+		false,
+		"display groups of files with the same content (implies --no-duplicates)",
+	)
+	flags.StringVar(
+		&processor.DuplicateHash,
+		"duplicate-hash",
+		"md5",
+		"hash used to detect duplicate files [fnv, md5, sha256]",
+	)
 	flags.StringSliceVar(
-		&processor.PathBlacklist,
+		&processor.ExcludeDir,
 		"exclude-dir",
 		[]string{".git", ".hg", ".svn"},
-		"directories to exclude",
+		"directory names to exclude at any depth, or paths when containing a separator",
+	)
+	flags.StringArrayVar(
+		&processor.ExcludePath,
+		"exclude-path",
+		[]string{},
+		"ignore files and directories whose path matches regular expression (can be repeated)",
 	)
 	flags.IntVar(
 		&processor.GcFileCount,
 		"file-gc-count",
 		10000,
-		"number of files to parse before turning the GC on",
+		"number of files to walk before turning the GC on",
+	)
+	flags.IntVar(
+		&processor.FilenameWidth,
+		"filename-width",
+		0,
+		"width of the file name column in tabular output with longer paths shortened from the start (default fills the width of the terminal)",
+	)
+	flags.BoolVar(
+		&processor.FilesOnly,
+		"files-only",
+		false,
+		"write only the record of each file as it is counted without adding them up, use with --format json or ndjson",
+	)
+	flags.BoolVar(
+		&processor.FollowSymlinks,
+		"follow-symlinks",
+		false,
+		"follow symlinked directories, walking each directory once",
+	)
+	flags.StringToStringVar(
+		&processor.ForceLanguage,
+		"force-language",
+		map[string]string{},
+		"treat files with the extension as the language [comma separated list: e.g. inc=PHP,tpl=HTML]",
 	)
 	flags.StringVarP(
 		&processor.Format,
 		"format",
 		"f",
 		"tabular",
-		"set output format [tabular, wide, json, csv]",
+		"set output format [tabular, wide, json, ndjson, yaml, html, csv, sql, wc, openmetrics]",
+	)
+	flags.StringVar(
+		&processor.FormatTemplate,
+		"format-template",
+		"",
+		"text/template file used to write the output rather than --format",
+	)
+	flags.BoolVar(
+		&processor.GcAuto,
+		"gc-auto",
+		false,
+		"leave the GC on at its default setting rather than turning it off until --file-gc-count files are read",
+	)
+	flags.StringVar(
+		&processor.GitRef,
+		"git-ref",
+		"",
+		"count the files at the git ref such as HEAD~5 without checking it out, run from within the repository",
+	)
+	flags.BoolVar(
+		&processor.GitTracked,
+		"git-tracked",
+		false,
+		"only count files tracked by git in the working tree, falls back to walking outside a repository",
+	)
+	flags.BoolVar(
+		&processor.Imports,
+		"imports",
+		false,
+		"count import lines such as Go's import blocks separately from code",
 	)
 	flags.StringSliceVarP(
 		&processor.WhiteListExtensions,
@@ -86,7 +274,95 @@ func main() {
 		"languages",
 		"l",
 		false,
-		"print supported languages and extensions",
+		"print supported languages and extensions, use with --format json for the full language definitions",
+	)
+	flags.StringVar(
+		&processor.LanguagesFile,
+		"languages-file",
+		"",
+		"JSON file of language definitions in the format of languages.json which override the built in languages",
+	)
+	flags.BoolVar(
+		&processor.LineLength,
+		"line-length",
+		false,
+		"add the average and maximum line length in characters of each language to the wide and json output",
+	)
+	flags.BoolVar(
+		&processor.LinesOnly,
+		"lines-only",
+		false,
+		"only count lines which is faster as code, comments, blanks and complexity are not calculated",
+	)
+	flags.BoolVar(
+		&processor.MarkdownFences,
+		"markdown-fences",
+		false,
+		"count the code in fenced code blocks of Markdown files as the language of the block",
+	)
+	flags.Var(
+		(*byteSizeValue)(&processor.MaxFileSize),
+		"max-file-size",
+		"skip files larger than this size such as 5MB, 512KB or 1GB where the units are powers of 1024",
+	)
+	flags.Int64Var(
+		&processor.MaxLines,
+		"max-lines",
+		0,
+		"skip files with more total lines than this, 0 for no limit",
+	)
+	flags.IntVar(
+		&processor.MaxWorkers,
+		"max-workers",
+		0,
+		"maximum number of workers used to walk directories and read and process files, 0 to base it on the number of CPUs",
+	)
+	flags.StringVar(
+		&processor.MemProfile,
+		"memprofile",
+		"",
+		"write a memory profile to the file once the run has finished for use with go tool pprof",
+	)
+	flags.Float64Var(
+		&processor.MinCommentRatio,
+		"min-comment-ratio",
+		0,
+		"exit with an error naming the languages under it if there are fewer comment lines for each line of code than this once the report is written, such as 0.1, 0 for no minimum",
+	)
+	flags.Int64Var(
+		&processor.MinLines,
+		"min-lines",
+		0,
+		"skip files with fewer total lines than this",
+	)
+	flags.BoolVar(
+		&processor.Minified,
+		"minified",
+		false,
+		"count minified files under the Minified language rather than their own",
+	)
+	flags.Int64Var(
+		&processor.MinifiedLineLength,
+		"minified-line-length",
+		255,
+		"average bytes per line over which a file is considered minified",
+	)
+	flags.Var(
+		(*byteSizeValue)(&processor.MmapThreshold),
+		"mmap-threshold",
+		"size from which files are memory mapped rather than read into memory such as 100MB, 0 to disable",
+	)
+	flags.BoolVar(
+		&processor.NoCocomo,
+		"no-cocomo",
+		false,
+		"remove COCOMO calculation output",
+	)
+	flags.BoolVar(
+		&processor.NoColor,
+		"no-color",
+		false,
+		"never colour the output, which is also disabled by setting NO_COLOR",
 	)
 	flags.BoolVarP(
 		&processor.Complexity,
@@ -95,6 +371,12 @@ func main() {
 		false,
 		"skip calculation of code complexity",
 	)
+	flags.StringSliceVar(
+		&processor.NoComplexityLanguages,
+		"no-complexity-language",
+		[]string{},
+		"languages to skip calculation of code complexity for [e.g. --no-complexity-language YAML,Makefile]",
+	)
 	flags.BoolVarP(
 		&processor.Duplicates,
 		"no-duplicates",
@@ -102,6 +384,18 @@ func main() {
 		false,
 		"remove duplicate files from stats and output",
 	)
+	flags.BoolVar(
+		&processor.NoGen,
+		"no-gen",
+		false,
+		"skip generated files which have a comment such as \"Code generated ... DO NOT EDIT.\" near the top",
+	)
+	flags.BoolVar(
+		&processor.NoMinified,
+		"no-minified",
+		false,
+		"skip minified files",
+	)
 	flags.StringVarP(
 		&processor.Exclude,
 		"not-match",
@@ -114,14 +408,98 @@ func main() {
 		"output",
 		"o",
 		"",
-		"output filename (default stdout)",
+		"output filename which is gzip compressed when ending in .gz (default stdout)",
+	)
+	flags.StringVar(
+		&processor.PathStyle,
+		"path-style",
+		"",
+		"write the path of each file relative to the path it was found under or as absolute [relative, absolute] (default as walked)",
+	)
+	flags.BoolVar(
+		&processor.Percent,
+		"percent",
+		false,
+		"display the share of the total each language and file is as a percentage",
+	)
+	flags.StringVar(
+		&processor.PercentOf,
+		"percent-of",
+		"code",
+		"what the percentage of --percent is of [code, lines]",
+	)
+	flags.BoolVar(
+		&processor.Ratios,
+		"ratios",
+		false,
+		"write the percentage of the lines of each language which are blank, comment and code",
+	)
+	flags.BoolVar(
+		&processor.Reverse,
+		"reverse",
+		false,
+		"reverse the order of the sort so the smallest are first or names are in reverse",
+	)
+	flags.StringVar(
+		&processor.Since,
+		"since",
+		"",
+		"only count the lines git blame says were changed after the date, which is slow on large repositories [e.g. --since 2024-01-01]",
+	)
+	flags.BoolVar(
+		&processor.ShowSkipped,
+		"skipped",
+		false,
+		"display files which were found but skipped and why",
 	)
 	flags.StringVarP(
 		&processor.SortBy,
 		"sort",
 		"s",
 		"files",
-		"column to sort by [files, name, lines, blanks, code, comments, complexity]",
+		"column to sort by with name being alphabetical ignoring case [files, name, lines, blanks, code, comments, complexity, complexity-per-line]",
+	)
+	flags.BoolVar(
+		&processor.SplitComponents,
+		"split-components",
+		false,
+		"count the script, style and markup of Vue and Svelte components as JavaScript, CSS and HTML",
+	)
+	flags.StringVar(
+		&processor.SQLTable,
+		"sql-table",
+		"t",
+		"table name used when the output format is sql",
+	)
+	flags.BoolVar(
+		&processor.Strict,
+		"strict",
+		false,
+		"list any files which could not be read and exit with an error rather than skipping them",
+	)
+	flags.BoolVar(
+		&processor.Tests,
+		"tests",
+		false,
+		"count test files such as *_test.go and test_*.py as a separate language and compare their code to the rest",
+	)
+	flags.StringVar(
+		&processor.ThousandsSeparator,
+		"thousands-separator",
+		",",
+		"set separator used to group thousands in COCOMO cost output",
+	)
+	flags.Int64Var(
+		&processor.TotalComplexityMax,
+		"total-complexity-max",
+		0,
+		"exit with an error if the total complexity is over this once the report is written, 0 for no maximum",
+	)
+	flags.BoolVar(
+		&processor.TotalOnly,
+		"total-only",
+		false,
+		"display only the total of every file, which is only the Total object for json and yaml",
 	)
 	flags.BoolVarP(
 		&processor.Trace,
@@ -130,6 +508,12 @@ func main() {
 		false,
 		"enable trace output. Not recommended when processing multiple files",
 	)
+	flags.BoolVar(
+		&processor.ULOC,
+		"uloc",
+		false,
+		"calculate the unique lines of code, ignoring surrounding whitespace",
+	)
 	flags.BoolVarP(
 		&processor.Verbose,
 		"verbose",
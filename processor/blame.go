@@ -0,0 +1,191 @@
+package processor
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The layouts accepted by --since
+var sinceLayouts = []string{"2006-01-02", time.RFC3339}
+
+// Set from Since by processFlags and zero when --since is not used
+var sinceTime time.Time
+
+// Parses the date given to --since as either a date or a date and time
+func parseSince(value string) (time.Time, error) {
+	for _, layout := range sinceLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("unable to parse --since date %s, expected a date such as 2006-01-02", value)
+}
+
+// Checks that --since can be used before any processing starts
+func checkSince() error {
+	for _, location := range DirFilePaths {
+		if dir, _ := gitPathspec(location); location != "-" && !isGitRepository(dir) {
+			return fmt.Errorf("--since requires %s to be in a git repository", location)
+		}
+	}
+
+	if LinesOnly {
+		return errors.New("--since cannot be used with --lines-only")
+	}
+
+	if SplitComponents || MarkdownFences {
+		return errors.New("--since cannot be used with --split-components or --markdown-fences")
+	}
+
+	return nil
+}
+
+// Returns the lines of the file, numbered from 1, which git blame says were last changed after
+// since. Lines which are not committed yet are included as git blame dates them as now. Git is
+// run in the directory of the file so that it is blamed in whichever repository it belongs to
+func blameRecentLines(ctx context.Context, location string, since time.Time) (map[int64]bool, error) {
+	args := []string{"-C", filepath.Dir(location), "blame", "--porcelain"}
+	if GitRef != "" {
+		args = append(args, GitRef)
+	}
+	args = append(args, "--", filepath.Base(location))
+
+	out, err := exec.CommandContext(ctx, "git", args...).Output()
+	if err != nil {
+		return nil, err
+	}
+
+	// The header of each line is <commit> <original line> <final line> [<lines in group>] and the
+	// details of each commit such as committer-time follow the first header which uses it
+	lines := map[int64]string{}
+	times := map[string]int64{}
+	commit := ""
+
+	scanner := bufio.NewScanner(bytes.NewReader(out))
+	scanner.Buffer(make([]byte, 64*1024), len(out)+1)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(line, "\t") {
+			continue
+		}
+
+		if strings.HasPrefix(line, "committer-time ") {
+			times[commit], _ = strconv.ParseInt(strings.TrimPrefix(line, "committer-time "), 10, 64)
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) >= 3 && len(fields[0]) >= 40 && isHex(fields[0]) {
+			commit = fields[0]
+			final, err := strconv.ParseInt(fields[2], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("unexpected blame header: %s", line)
+			}
+			lines[final] = commit
+		}
+	}
+
+	recent := map[int64]bool{}
+	for line, commit := range lines {
+		if times[commit] > since.Unix() {
+			recent[line] = true
+		}
+	}
+
+	return recent, nil
+}
+
+// Returns true if the file is in a git repository but git does not track it, which git blame
+// refuses to blame even though none of its lines have been committed
+func isGitUntracked(ctx context.Context, location string) bool {
+	dir := filepath.Dir(location)
+	if !isGitRepository(dir) {
+		return false
+	}
+
+	out, err := exec.CommandContext(ctx, "git", "-C", dir, "ls-files", "--", filepath.Base(location)).Output()
+	return err == nil && len(bytes.TrimSpace(out)) == 0
+}
+
+// Returns true if the value is made up of only hexadecimal digits such as a commit hash
+func isHex(value string) bool {
+	for _, c := range value {
+		if !(c >= '0' && c <= '9') && !(c >= 'a' && c <= 'f') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Counts only the lines which were changed recently as CountStats calls back with each line.
+// Complexity is counted for a line when it increases while the line is being counted
+type recentCounter struct {
+	recent         map[int64]bool
+	next           FileJobCallback
+	lastComplexity int64
+	lines          int64
+	code           int64
+	comment        int64
+	blank          int64
+	complexity     int64
+}
+
+func (c *recentCounter) ProcessLine(job *FileJob, currentLine int64, lineType LineType) bool {
+	if c.recent[currentLine] {
+		c.lines++
+		c.complexity += job.Complexity - c.lastComplexity
+
+		switch lineType {
+		case LINE_CODE:
+			c.code++
+		case LINE_COMMENT:
+			c.comment++
+		case LINE_BLANK:
+			c.blank++
+		}
+	}
+	c.lastComplexity = job.Complexity
+
+	if c.next != nil {
+		return c.next.ProcessLine(job, currentLine, lineType)
+	}
+
+	return true
+}
+
+// Counts the file keeping only the lines which git blame says were changed after sinceTime
+// for --since. Run by each of the processor workers so the files are blamed in parallel
+func countStatsSince(ctx context.Context, fileJob *FileJob) error {
+	recent, err := blameRecentLines(ctx, fileJob.Location, sinceTime)
+	if err != nil {
+		// Every line of an untracked file is as recent as the uncommitted lines of a tracked one
+		if GitRef == "" && isGitUntracked(ctx, fileJob.Location) {
+			CountStats(fileJob)
+			return nil
+		}
+		return err
+	}
+
+	counter := &recentCounter{recent: recent, next: fileJob.Callback}
+	fileJob.Callback = counter
+	CountStats(fileJob)
+	fileJob.Callback = counter.next
+
+	fileJob.Lines = counter.lines
+	fileJob.Code = counter.code
+	fileJob.Comment = counter.comment
+	fileJob.Blank = counter.blank
+	fileJob.Complexity = counter.complexity
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package processor
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestParseSince(t *testing.T) {
+	got, err := parseSince("2020-02-03")
+	if err != nil || !got.Equal(time.Date(2020, 2, 3, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("Expected 2020-02-03 got %v %v", got, err)
+	}
+
+	if _, err := parseSince("2020-02-03T04:05:06Z"); err != nil {
+		t.Errorf("Expected no error got %s", err)
+	}
+
+	if _, err := parseSince("last week"); err == nil {
+		t.Error("Expected error for invalid date")
+	}
+}
+
+func TestCountStatsSince(t *testing.T) {
+	ProcessConstants()
+	defer createGitRepository(t)()
+
+	content := "package main\n\n// Runs it\nfunc main() {\n}\n"
+	ioutil.WriteFile("main.go", []byte(content), 0600)
+	git(t, "add", ".")
+	os.Setenv("GIT_COMMITTER_DATE", "2001-01-01T00:00:00Z")
+	git(t, "commit", "-q", "-m", "first")
+	os.Unsetenv("GIT_COMMITTER_DATE")
+
+	// One committed and one uncommitted change which are both after the date
+	content = "package main\n\n// Runs it\nfunc main() {\n\tif true {\n\t}\n}\n"
+	ioutil.WriteFile("main.go", []byte(content), 0600)
+
+	sinceTime = time.Date(2010, 1, 1, 0, 0, 0, 0, time.UTC)
+	defer func() { sinceTime = time.Time{} }()
+
+	fileJob := FileJob{Language: "Go", Location: "main.go", Content: []byte(content)}
+	if err := countStatsSince(context.Background(), &fileJob); err != nil {
+		t.Fatalf("Expected no error got %s", err)
+	}
+
+	if fileJob.Lines != 2 || fileJob.Code != 2 || fileJob.Comment != 0 || fileJob.Blank != 0 || fileJob.Complexity != 1 {
+		t.Errorf("Expected 2 lines 2 code 1 complexity got %d %d %d %d %d", fileJob.Lines, fileJob.Code, fileJob.Comment, fileJob.Blank, fileJob.Complexity)
+	}
+
+	// Untracked files are counted in full and blamed from their own repository
+	ioutil.WriteFile("untracked.go", []byte(content), 0600)
+	repository, _ := os.Getwd()
+	os.Chdir(os.TempDir())
+
+	fileJob = FileJob{Language: "Go", Location: filepath.Join(repository, "untracked.go"), Content: []byte(content)}
+	if err := countStatsSince(context.Background(), &fileJob); err != nil || fileJob.Lines != 7 || fileJob.Code != 5 {
+		t.Errorf("Expected 7 lines 5 code got %d %d %v", fileJob.Lines, fileJob.Code, err)
+	}
+
+	fileJob = FileJob{Language: "Go", Location: filepath.Join(repository, "main.go"), Content: []byte(content)}
+	if err := countStatsSince(context.Background(), &fileJob); err != nil || fileJob.Lines != 2 {
+		t.Errorf("Expected 2 lines got %d %v", fileJob.Lines, err)
+	}
+
+	outside, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(outside)
+	ioutil.WriteFile(filepath.Join(outside, "main.go"), []byte(content), 0600)
+
+	fileJob = FileJob{Language: "Go", Location: filepath.Join(outside, "main.go"), Content: []byte(content)}
+	if err := countStatsSince(context.Background(), &fileJob); err == nil {
+		t.Error("Expected error for file outside of a git repository")
+	}
+}
@@ -0,0 +1,127 @@
+package processor
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// A file with complexity over ComplexityMax
+type complexityOverage struct {
+	Location   string
+	Complexity int64
+}
+
+// Checks the complexity of each file against ComplexityMax and the total against
+// TotalComplexityMax so that scc can fail a build once the report has been written
+type complexityBudget struct {
+	files []complexityOverage
+	total int64
+}
+
+// Passes the jobs through while checking them against the budget
+func withComplexityBudget(input chan *FileJob, budget *complexityBudget) chan *FileJob {
+	forwarded := make(chan *FileJob, FileSummaryJobQueueSize)
+
+	go func() {
+		for res := range input {
+			budget.total += res.Complexity
+			if ComplexityMax > 0 && res.Complexity > ComplexityMax {
+				budget.files = append(budget.files, complexityOverage{Location: res.Location, Complexity: res.Complexity})
+			}
+
+			forwarded <- res
+		}
+		close(forwarded)
+	}()
+
+	return forwarded
+}
+
+// Returns an error naming each file over ComplexityMax, most complex first, and the
+// total when it is over TotalComplexityMax, or nil if everything is within budget
+func (budget *complexityBudget) err() error {
+	var str strings.Builder
+
+	if len(budget.files) != 0 {
+		sort.Slice(budget.files, func(i, j int) bool {
+			if budget.files[i].Complexity != budget.files[j].Complexity {
+				return budget.files[i].Complexity > budget.files[j].Complexity
+			}
+			return budget.files[i].Location < budget.files[j].Location
+		})
+
+		str.WriteString(fmt.Sprintf("%d files are over the maximum complexity of %d", len(budget.files), ComplexityMax))
+		for _, file := range budget.files {
+			str.WriteString(fmt.Sprintf("\n%s: %d", file.Location, file.Complexity))
+		}
+	}
+
+	if TotalComplexityMax > 0 && budget.total > TotalComplexityMax {
+		if str.Len() != 0 {
+			str.WriteString("\n")
+		}
+		str.WriteString(fmt.Sprintf("total complexity of %d is over the maximum of %d", budget.total, TotalComplexityMax))
+	}
+
+	if str.Len() == 0 {
+		return nil
+	}
+
+	return errors.New(str.String())
+}
+
+// Returns the number of comment lines for each line of code, which is used by MinCommentRatio and
+// differs from LanguageSummary.CommentRatio which is the percentage of the lines which are comments.
+// Without any code there is nothing to document so it is never under the minimum
+func commentsPerCode(summary LanguageSummary) float64 {
+	if summary.Code == 0 {
+		return math.Inf(1)
+	}
+	return float64(summary.Comment) / float64(summary.Code)
+}
+
+// Checks the ratio of comment to code lines of the summarised languages against MinCommentRatio
+// so that scc can fail a build which is not documented enough once the report has been written.
+// Returns an error when the ratio of every language together is under the minimum naming the
+// languages under it, those missing the most comment lines first, or nil otherwise
+func commentBudgetError(language []LanguageSummary) error {
+	if MinCommentRatio <= 0 {
+		return nil
+	}
+
+	total := LanguageSummary{}
+	under := []LanguageSummary{}
+	for _, summary := range language {
+		total.Code += summary.Code
+		total.Comment += summary.Comment
+
+		if commentsPerCode(summary) < MinCommentRatio {
+			under = append(under, summary)
+		}
+	}
+
+	if commentsPerCode(total) >= MinCommentRatio {
+		return nil
+	}
+
+	missing := func(summary LanguageSummary) float64 {
+		return MinCommentRatio*float64(summary.Code) - float64(summary.Comment)
+	}
+	sort.Slice(under, func(i, j int) bool {
+		if missing(under[i]) != missing(under[j]) {
+			return missing(under[i]) > missing(under[j])
+		}
+		return under[i].Name < under[j].Name
+	})
+
+	var str strings.Builder
+	str.WriteString(fmt.Sprintf("comment ratio of %.2f is under the minimum of %.2f", commentsPerCode(total), MinCommentRatio))
+	for _, summary := range under {
+		str.WriteString(fmt.Sprintf("\n%s: %.2f with %d comment lines for %d code lines", summary.Name, commentsPerCode(summary), summary.Comment, summary.Code))
+	}
+
+	return errors.New(str.String())
+}
@@ -0,0 +1,120 @@
+package processor
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestComplexityBudget(t *testing.T) {
+	defer func() {
+		ComplexityMax = 0
+		TotalComplexityMax = 0
+	}()
+
+	check := func() error {
+		input := make(chan *FileJob, 10)
+		input <- &FileJob{Location: "simple.go", Complexity: 2}
+		input <- &FileJob{Location: "busy.go", Complexity: 12}
+		input <- &FileJob{Location: "hotspot.go", Complexity: 30}
+		close(input)
+
+		budget := &complexityBudget{}
+		count := 0
+		for range withComplexityBudget(input, budget) {
+			count++
+		}
+
+		if count != 3 {
+			t.Errorf("Expected every file to be passed through got %d", count)
+		}
+
+		return budget.err()
+	}
+
+	if err := check(); err != nil {
+		t.Errorf("Expected no error without a maximum got %s", err)
+	}
+
+	ComplexityMax = 10
+	err := check()
+	if err == nil || !strings.HasPrefix(err.Error(), "2 files are over the maximum complexity of 10\nhotspot.go: 30\nbusy.go: 12") {
+		t.Errorf("Expected hotspot.go and busy.go to be named got %v", err)
+	}
+
+	if strings.Contains(err.Error(), "total") {
+		t.Errorf("Expected no total without a maximum got %s", err)
+	}
+
+	ComplexityMax = 0
+	TotalComplexityMax = 44
+	if err := check(); err != nil {
+		t.Errorf("Expected total of 44 to be within budget got %s", err)
+	}
+
+	TotalComplexityMax = 40
+	if err := check(); err == nil || err.Error() != "total complexity of 44 is over the maximum of 40" {
+		t.Errorf("Expected total to be over budget got %v", err)
+	}
+}
+
+func TestCommentBudget(t *testing.T) {
+	defer func() { MinCommentRatio = 0 }()
+
+	check := func() error {
+		input := make(chan *FileJob, 10)
+		input <- &FileJob{Language: "Go", Location: "main.go", Code: 100, Comment: 20}
+		input <- &FileJob{Language: "Java", Location: "Main.java", Code: 200, Comment: 4}
+		input <- &FileJob{Language: "C", Location: "main.c", Code: 10, Comment: 0}
+		input <- &FileJob{Language: "Markdown", Location: "README.md", Code: 0, Comment: 0, Blank: 3}
+		close(input)
+
+		return commentBudgetError(aggregateLanguageSummary(input))
+	}
+
+	if err := check(); err != nil {
+		t.Errorf("Expected no error without a minimum got %s", err)
+	}
+
+	MinCommentRatio = 0.07
+	if err := check(); err != nil {
+		t.Errorf("Expected total ratio of 0.08 to be over the minimum got %s", err)
+	}
+
+	MinCommentRatio = 0.1
+	expected := "comment ratio of 0.08 is under the minimum of 0.10\nJava: 0.02 with 4 comment lines for 200 code lines\nC: 0.00 with 0 comment lines for 10 code lines"
+	if err := check(); err == nil || err.Error() != expected {
+		t.Errorf("Expected %s got %v", expected, err)
+	}
+}
+
+func TestCommentBudgetProcess(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	ioutil.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n\nfunc main() {}\n"), 0600)
+
+	cfg := DefaultConfig()
+	cfg.DirFilePaths = []string{dir}
+	cfg.FileOutput = filepath.Join(dir, "out.json")
+	cfg.Format = "json"
+	cfg.MinCommentRatio = 0.1
+
+	// Checked against the summary the output was written from
+	expected := "comment ratio of 0.00 is under the minimum of 0.10\nGo: 0.00 with 0 comment lines for 2 code lines"
+	if err := ProcessContextConfig(context.Background(), cfg); err == nil || err.Error() != expected {
+		t.Errorf("Expected %s got %v", expected, err)
+	}
+
+	if _, err := os.Stat(cfg.FileOutput); err != nil {
+		t.Errorf("Expected the output to be written first got %s", err)
+	}
+
+	cfg.Format = "ndjson"
+	if err := ProcessContextConfig(context.Background(), cfg); err == nil || !strings.Contains(err.Error(), "languages are not summarised") {
+		t.Errorf("Expected formats without languages to be rejected got %v", err)
+	}
+}
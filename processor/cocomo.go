@@ -1,25 +1,121 @@
 package processor
 
 import (
+	"fmt"
 	"math"
+	"strconv"
+	"strings"
 )
 
-// Calculate the cost in dollars applied using generic COCOMO2 weighted values based
+// CocomoParams are the coefficients used for the COCOMO effort and schedule estimates
+// where effort = Effort * KSLOC^EffortExp and schedule = Schedule * effort^ScheduleExp
+type CocomoParams struct {
+	Effort      float64
+	EffortExp   float64
+	Schedule    float64
+	ScheduleExp float64
+}
+
+// Coefficients for each of the standard COCOMO project types
+var CocomoProjectTypes = map[string]CocomoParams{
+	"organic":       {Effort: 3.2, EffortExp: 1.05, Schedule: 2.5, ScheduleExp: 0.38},
+	"semi-detached": {Effort: 3.0, EffortExp: 1.12, Schedule: 2.5, ScheduleExp: 0.35},
+	"embedded":      {Effort: 2.8, EffortExp: 1.20, Schedule: 2.5, ScheduleExp: 0.32},
+}
+
+// The coefficients used for the estimates which default to an organic project,
+// small team, good experience working with requirements
+var CocomoProject = CocomoProjectTypes["organic"]
+
+// Weight applied to the code of each language before the estimates are made as a line of
+// some languages such as assembly takes more effort than others. Unlisted languages have a weight of 1
+var cocomoWeights = map[string]float64{}
+
+// Calculate the cost in the currency of the wage applied using generic COCOMO2 weighted values based
 // on the average yearly wage
-func EstimateCost(effortApplied float64, averageWage int64) float64 {
-	return effortApplied * float64(averageWage/12) * float64(1.8)
+func EstimateCost(effortApplied float64, averageWage float64) float64 {
+	return effortApplied * (averageWage / 12) * float64(1.8)
 }
 
 // Calculate the effort applied using generic COCOMO2 weighted values
 func EstimateEffort(sloc int64) float64 {
 	var eaf float64 = 1
 
-	// Numbers based on organic project, small team, good experience working with requirements
-	var effortApplied float64 = float64(3.2) * math.Pow(float64(sloc)/1000, 1.05) * eaf
+	var effortApplied float64 = CocomoProject.Effort * math.Pow(float64(sloc)/1000, CocomoProject.EffortExp) * eaf
 	return effortApplied
 }
 
 func EstimateScheduleMonths(effortApplied float64) float64 {
-	// Numbers based on organic project small team, good experience working with requirements
-	return float64(2.5) * math.Pow(effortApplied, 0.38)
+	return CocomoProject.Schedule * math.Pow(effortApplied, CocomoProject.ScheduleExp)
+}
+
+// Parses either the name of one of the standard project types such as organic
+// or custom coefficients in the form custom,effort,effortexp,schedule,scheduleexp
+func parseCocomoProjectType(projectType string) (CocomoParams, error) {
+	projectType = strings.ToLower(strings.TrimSpace(projectType))
+
+	if params, ok := CocomoProjectTypes[projectType]; ok {
+		return params, nil
+	}
+
+	values := strings.Split(projectType, ",")
+	if values[0] != "custom" || len(values) != 5 {
+		return CocomoParams{}, fmt.Errorf("unknown COCOMO project type %s expected organic, semi-detached, embedded or custom,effort,effortexp,schedule,scheduleexp", projectType)
+	}
+
+	var coefficients [4]float64
+	for i, value := range values[1:] {
+		coefficient, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return CocomoParams{}, fmt.Errorf("invalid COCOMO coefficient %s: %v", value, err)
+		}
+		coefficients[i] = coefficient
+	}
+
+	return CocomoParams{
+		Effort:      coefficients[0],
+		EffortExp:   coefficients[1],
+		Schedule:    coefficients[2],
+		ScheduleExp: coefficients[3],
+	}, nil
+}
+
+// Parses the weight for each language matching the names of the languages without case
+func parseCocomoWeights(weights map[string]string) (map[string]float64, error) {
+	languages := map[string]string{}
+	for name := range LanguageFeatures {
+		languages[strings.ToLower(name)] = name
+	}
+
+	parsed := map[string]float64{}
+	for language, value := range weights {
+		name, ok := languages[strings.ToLower(strings.TrimSpace(language))]
+		if !ok {
+			return nil, fmt.Errorf("unknown language %s for COCOMO weight", language)
+		}
+
+		weight, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || weight < 0 {
+			return nil, fmt.Errorf("invalid COCOMO weight %s for %s", value, language)
+		}
+
+		parsed[name] = weight
+	}
+
+	return parsed, nil
+}
+
+// Sums the code of the languages for the estimates applying the weight of each language
+func weightedCode(language []LanguageSummary) int64 {
+	var sum float64
+	for _, summary := range language {
+		weight, ok := cocomoWeights[summary.Name]
+		if !ok {
+			weight = 1
+		}
+
+		sum += float64(summary.Code) * weight
+	}
+
+	return int64(math.Round(sum))
 }
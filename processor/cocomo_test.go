@@ -18,8 +18,8 @@ func TestEstimateCostManyLines(t *testing.T) {
 	eff := EstimateEffort(77873)
 	got := EstimateCost(eff, 56000)
 
-	// Should be around 2602096
-	if got < 2602000 || got > 2602100 {
+	// Should be around 2602468
+	if got < 2602400 || got > 2602500 {
 		t.Errorf("Got %f", got)
 	}
 }
@@ -33,3 +33,80 @@ func TestEstimateScheduleMonths(t *testing.T) {
 		t.Errorf("Got %f", got)
 	}
 }
+
+func TestEstimateEffortEmbedded(t *testing.T) {
+	CocomoProject = CocomoProjectTypes["embedded"]
+	defer func() { CocomoProject = CocomoProjectTypes["organic"] }()
+
+	// 2.8 * 10^1.2
+	got := EstimateEffort(10000)
+	if got < 44.3 || got > 44.4 {
+		t.Errorf("Got %f", got)
+	}
+}
+
+func TestParseCocomoProjectType(t *testing.T) {
+	got, err := parseCocomoProjectType("Semi-Detached")
+	if err != nil || got != CocomoProjectTypes["semi-detached"] {
+		t.Errorf("Expected semi-detached got %+v %v", got, err)
+	}
+
+	got, err = parseCocomoProjectType("custom,1,2,3,4")
+	expected := CocomoParams{Effort: 1, EffortExp: 2, Schedule: 3, ScheduleExp: 4}
+	if err != nil || got != expected {
+		t.Errorf("Expected %+v got %+v %v", expected, got, err)
+	}
+}
+
+func TestParseCocomoProjectTypeInvalid(t *testing.T) {
+	for _, projectType := range []string{"", "unknown", "custom,1,2,3", "custom,1,2,3,a"} {
+		if _, err := parseCocomoProjectType(projectType); err == nil {
+			t.Errorf("Expected error for %s", projectType)
+		}
+	}
+}
+
+func TestEstimateCostFractionalWage(t *testing.T) {
+	eff := EstimateEffort(77873)
+	got := EstimateCost(eff, 56000.6)
+
+	// Should be around 2602496
+	if got < 2602490 || got > 2602500 {
+		t.Errorf("Got %f", got)
+	}
+}
+
+func TestParseCocomoWeights(t *testing.T) {
+	ProcessConstants()
+
+	got, err := parseCocomoWeights(map[string]string{"assembly": "2.5", " Python ": "0.5"})
+	if err != nil || len(got) != 2 || got["Assembly"] != 2.5 || got["Python"] != 0.5 {
+		t.Errorf("Expected weights for Assembly and Python got %v %v", got, err)
+	}
+
+	for language, weight := range map[string]string{"Unknown": "1", "Go": "a", "Java": "-1"} {
+		if _, err := parseCocomoWeights(map[string]string{language: weight}); err == nil {
+			t.Errorf("Expected error for %s=%s", language, weight)
+		}
+	}
+}
+
+func TestWeightedCode(t *testing.T) {
+	language := []LanguageSummary{
+		{Name: "Assembly", Code: 100},
+		{Name: "Python", Code: 101},
+		{Name: "Go", Code: 10},
+	}
+
+	if got := weightedCode(language); got != 211 {
+		t.Errorf("Expected 211 without weights got %d", got)
+	}
+
+	cocomoWeights = map[string]float64{"Assembly": 2.5, "Python": 0.5}
+	defer func() { cocomoWeights = map[string]float64{} }()
+
+	// 250 + 50.5 + 10 rounded
+	if got := weightedCode(language); got != 311 {
+		t.Errorf("Expected 311 got %d", got)
+	}
+}
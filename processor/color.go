@@ -0,0 +1,53 @@
+package processor
+
+import (
+	"os"
+	"strings"
+)
+
+// The complexity of a file at which it is coloured as a warning and as a hotspot
+// when the files are written to a terminal, anything lower is coloured as fine
+var ComplexityWarning int64 = 10
+var ComplexityHotspot int64 = 25
+
+// ANSI escapes for the colours used in the output
+const (
+	colorReset  = "\x1b[0m"
+	colorRed    = "\x1b[31m"
+	colorGreen  = "\x1b[32m"
+	colorYellow = "\x1b[33m"
+)
+
+// Returns true if the output is written to a terminal, which is a variable so
+// that tests can pretend to write to a terminal
+var outputIsTerminal = func() bool {
+	return FileOutput == "" && isTerminal(os.Stdout)
+}
+
+// Colour is only used when writing to a terminal unless disabled using --no-color or NO_COLOR
+// as described by https://no-color.org/ which anything writing ANSI escapes must check
+func colorEnabled() bool {
+	if NoColor || os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+
+	return outputIsTerminal()
+}
+
+// Returns the colour of a file based on how its complexity compares to the thresholds
+func complexityColor(complexity int64) string {
+	switch {
+	case complexity >= ComplexityHotspot:
+		return colorRed
+	case complexity >= ComplexityWarning:
+		return colorYellow
+	}
+
+	return colorGreen
+}
+
+// Colours a row of output leaving the newline outside of the escapes, which
+// should only be called once colorEnabled has been checked
+func colorRow(row string, color string) string {
+	return color + strings.TrimSuffix(row, "\n") + colorReset + "\n"
+}
@@ -0,0 +1,42 @@
+package processor
+
+import (
+	"os"
+	"testing"
+)
+
+func TestColorEnabled(t *testing.T) {
+	original := outputIsTerminal
+	defer func() {
+		outputIsTerminal = original
+		NoColor = false
+		os.Unsetenv("NO_COLOR")
+	}()
+
+	outputIsTerminal = func() bool { return false }
+	if colorEnabled() {
+		t.Error("Expected no colour when not writing to a terminal")
+	}
+
+	outputIsTerminal = func() bool { return true }
+	if !colorEnabled() {
+		t.Error("Expected colour when writing to a terminal")
+	}
+
+	NoColor = true
+	if colorEnabled() {
+		t.Error("Expected no colour with --no-color")
+	}
+
+	NoColor = false
+	os.Setenv("NO_COLOR", "1")
+	if colorEnabled() {
+		t.Error("Expected no colour with NO_COLOR set")
+	}
+}
+
+func TestColorRow(t *testing.T) {
+	if got := colorRow("text\n", colorRed); got != colorRed+"text"+colorReset+"\n" {
+		t.Errorf("Expected coloured text got %q", got)
+	}
+}
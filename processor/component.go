@@ -0,0 +1,130 @@
+package processor
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+)
+
+// Languages of single file components which are split into a job per language when SplitComponents is set
+var componentLanguages = map[string]bool{
+	"Vue":    true,
+	"Svelte": true,
+}
+
+// The language the markup of a component is counted as, which is everything outside of its script and style tags
+const componentMarkupLanguage = "HTML"
+
+var componentOpenTag = regexp.MustCompile(`(?i)<(script|style)\b([^>]*)>`)
+var componentCloseTags = map[string]*regexp.Regexp{
+	"script": regexp.MustCompile(`(?i)</script\s*>`),
+	"style":  regexp.MustCompile(`(?i)</style\s*>`),
+}
+var componentLangAttribute = regexp.MustCompile(`(?i)\blang\s*=\s*["']?([\w-]+)`)
+
+// Values of the lang attribute which are not the extension of the language they are for
+var componentLangAliases = map[string]string{
+	"javascript":   "js",
+	"typescript":   "ts",
+	"coffeescript": "coffee",
+	"stylus":       "styl",
+	"postcss":      "css",
+}
+
+// Returns the language of the content of a script or style tag using its lang attribute when it has one
+func componentSectionLanguage(tag string, attributes string) string {
+	language := "JavaScript"
+	if strings.EqualFold(tag, "style") {
+		language = "CSS"
+	}
+
+	if match := componentLangAttribute.FindStringSubmatch(attributes); match != nil {
+		extension := strings.ToLower(match[1])
+		if alias, ok := componentLangAliases[extension]; ok {
+			extension = alias
+		}
+
+		if lang, ok := extensionLanguage(extension); ok {
+			return lang
+		}
+	}
+
+	return language
+}
+
+// Splits the content of a single file component such as a .vue or .svelte file into the content
+// of each language. The content of each script and style tag is counted as its language and
+// everything else, including the tags themselves, as HTML. The order is markup and then each tag
+func splitComponent(content []byte) ([]string, [][]byte) {
+	languages := []string{}
+	sections := [][]byte{}
+	markup := []byte{}
+
+	for {
+		open := componentOpenTag.FindSubmatchIndex(content)
+		if open == nil {
+			break
+		}
+
+		tag := string(content[open[2]:open[3]])
+		closeTag := componentCloseTags[strings.ToLower(tag)].FindIndex(content[open[1]:])
+		if closeTag == nil {
+			break
+		}
+
+		body := content[open[1] : open[1]+closeTag[0]]
+		markup = append(markup, content[:open[1]]...)
+		if bytes.IndexByte(body, '\n') != -1 {
+			markup = append(markup, '\n')
+		}
+
+		// The end of the line the open tag is on belongs to the markup when there is nothing after the tag
+		body = bytes.TrimPrefix(bytes.TrimPrefix(body, []byte("\r")), []byte("\n"))
+		if len(bytes.TrimSpace(body)) != 0 {
+			languages = append(languages, componentSectionLanguage(tag, string(content[open[4]:open[5]])))
+			sections = append(sections, append([]byte{}, body...))
+		}
+
+		content = content[open[1]+closeTag[0]:]
+	}
+	markup = append(markup, content...)
+
+	return append([]string{componentMarkupLanguage}, languages...), append([][]byte{markup}, sections...)
+}
+
+// Returns the jobs to count for the file, which is a job for each language embedded in it when it is
+// a single file component and SplitComponents is set or it is Markdown and MarkdownFences is set,
+// and otherwise just the file. The jobs have their own copy of the content so the file is released.
+// The jobs share sectionFile so that whichever of them is counted first is counted as the file
+func embeddedJobs(fileJob *FileJob) []*FileJob {
+	var split func([]byte) ([]string, [][]byte)
+	switch {
+	case SplitComponents && componentLanguages[fileJob.Language]:
+		split = splitComponent
+	case MarkdownFences && fileJob.Language == markdownLanguage:
+		split = splitMarkdown
+	default:
+		return []*FileJob{fileJob}
+	}
+
+	languages, sections := split(fileJob.Content)
+	releaseContent(fileJob)
+
+	sectionFile := new(int32)
+	jobs := []*FileJob{}
+	for i, section := range sections {
+		jobs = append(jobs, &FileJob{
+			Language:    languages[i],
+			Filename:    fileJob.Filename,
+			Extension:   fileJob.Extension,
+			Location:    fileJob.Location,
+			Content:     section,
+			Bytes:       int64(len(section)),
+			ModTime:     fileJob.ModTime,
+			Callback:    fileJob.Callback,
+			sectionFile: sectionFile,
+		})
+	}
+
+	return jobs
+}
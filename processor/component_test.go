@@ -0,0 +1,140 @@
+package processor
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+func TestSplitComponent(t *testing.T) {
+	ProcessConstants()
+
+	content := []byte(`<template>
+  <div>{{ msg }}</div>
+</template>
+
+<script lang="ts">
+export default {}
+</script>
+
+<style scoped>
+div { color: red; }
+</style>
+<style lang="scss"></style>
+`)
+
+	languages, sections := splitComponent(content)
+
+	expectedLanguages := []string{"HTML", "TypeScript", "CSS"}
+	if !reflect.DeepEqual(languages, expectedLanguages) {
+		t.Errorf("Expected %v got %v", expectedLanguages, languages)
+	}
+
+	expectedSections := []string{
+		"<template>\n  <div>{{ msg }}</div>\n</template>\n\n<script lang=\"ts\">\n</script>\n\n<style scoped>\n</style>\n<style lang=\"scss\"></style>\n",
+		"export default {}\n",
+		"div { color: red; }\n",
+	}
+	for i := range sections {
+		if i >= len(expectedSections) || string(sections[i]) != expectedSections[i] {
+			t.Errorf("Expected section %d to be %q got %q", i, expectedSections, sections[i])
+		}
+	}
+}
+
+func TestComponentSectionLanguage(t *testing.T) {
+	ProcessConstants()
+
+	tests := []struct {
+		tag        string
+		attributes string
+		expected   string
+	}{
+		{"script", "", "JavaScript"},
+		{"script", ` lang="typescript"`, "TypeScript"},
+		{"script", ` context="module" lang='ts'`, "TypeScript"},
+		{"style", "", "CSS"},
+		{"style", ` lang="less"`, "LESS"},
+		{"style", ` lang="unknown"`, "CSS"},
+	}
+
+	for _, test := range tests {
+		if got := componentSectionLanguage(test.tag, test.attributes); got != test.expected {
+			t.Errorf("Expected %s for %s got %s", test.expected, test.attributes, got)
+		}
+	}
+}
+
+func TestEmbeddedJobsComponent(t *testing.T) {
+	ProcessConstants()
+
+	fileJob := &FileJob{Language: "Svelte", Location: "App.svelte", Content: []byte("<script>\nlet x = 1\n</script>\n<p>{x}</p>\n")}
+	if jobs := embeddedJobs(fileJob); len(jobs) != 1 || jobs[0] != fileJob {
+		t.Errorf("Expected the file when not splitting components got %v", jobs)
+	}
+
+	SplitComponents = true
+	defer func() { SplitComponents = false }()
+
+	jobs := embeddedJobs(fileJob)
+	if len(jobs) != 2 || jobs[0].Language != "HTML" || jobs[1].Language != "JavaScript" || jobs[1].Location != "App.svelte" {
+		t.Fatalf("Expected HTML and JavaScript jobs got %v", jobs)
+	}
+
+	if jobs[1].Bytes != int64(len("let x = 1\n")) {
+		t.Errorf("Expected bytes of the section got %d", jobs[1].Bytes)
+	}
+}
+
+func TestEmbeddedJobsCountedAsOneFile(t *testing.T) {
+	ProcessConstants()
+	SplitComponents = true
+	defer func() { SplitComponents = false }()
+
+	language := processComponents("<p>x</p>\n<script>\nlet x = 1\n</script>\n<style>\np { color: red; }\n</style>\n")
+	if total := aggregateTotal(language); total.Files != 2 {
+		t.Errorf("Expected 2 files got %d", total.Files)
+	}
+
+	for _, summary := range language {
+		if summary.Count != 2 {
+			t.Errorf("Expected 2 files of %s got %d", summary.Name, summary.Count)
+		}
+	}
+}
+
+func TestEmbeddedJobsCountedWhenFirstSectionSkipped(t *testing.T) {
+	ProcessConstants()
+	SplitComponents = true
+	MinLines = 3
+	defer func() {
+		SplitComponents = false
+		MinLines = 0
+	}()
+	defer skipped.Reset()
+
+	// Without a template the markup is only the script tags which is too short to be counted
+	language := processComponents("<script>\nlet x = 1\nlet y = 2\nlet z = 3\n</script>\n")
+	if total := aggregateTotal(language); total.Files != 2 {
+		t.Errorf("Expected 2 files got %d", total.Files)
+	}
+
+	if len(language) != 1 || language[0].Name != "JavaScript" {
+		t.Errorf("Expected only JavaScript got %v", language)
+	}
+}
+
+// Counts a component with the given content in a.vue and b.vue through fileProcessorWorker
+func processComponents(content string) []LanguageSummary {
+	input := make(chan *FileJob, 10)
+	output := make(chan *FileJob, 10)
+	for _, location := range []string{"a.vue", "b.vue"} {
+		for _, job := range embeddedJobs(&FileJob{Language: "Vue", Location: location, Content: []byte(content)}) {
+			input <- job
+		}
+	}
+	close(input)
+
+	fileProcessorWorker(context.Background(), input, output)
+	return aggregateLanguageSummary(output)
+}
@@ -0,0 +1,143 @@
+package processor
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/spf13/pflag"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+)
+
+// The config file which is loaded from the current directory when --config is not used
+const DefaultConfigFile = ".scc"
+
+// LoadConfig sets any flags which were not set on the command line from ConfigFile or, when that
+// is not set, from a .scc file in the current directory if there is one. Needs to be called after
+// the flags have been parsed and before Process so that the command line overrides the config.
+// An error is returned if the config cannot be read or has an invalid value for a flag
+func LoadConfig(flags *pflag.FlagSet) error {
+	name, required := ConfigFile, true
+	if name == "" {
+		name, required = DefaultConfigFile, false
+	}
+
+	return applyConfig(flags, name, required)
+}
+
+// Reads the config which is a JSON object of flag names to values, such as {"sort": "code",
+// "exclude-dir": [".git", "vendor"]}, setting each flag which was not set on the command line.
+// Unknown flags are warned about and skipped so an older version can still use the file
+func applyConfig(flags *pflag.FlagSet, name string, required bool) error {
+	content, err := ioutil.ReadFile(name)
+	if err != nil {
+		if !required && os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.UseNumber()
+
+	config := map[string]interface{}{}
+	if err := decoder.Decode(&config); err != nil {
+		return fmt.Errorf("unable to read config %s: %s", name, err)
+	}
+
+	// Sorted so that the flags are set and any warnings written in the same order every run
+	keys := []string{}
+	for key := range config {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	unknown := []string{}
+	for _, key := range keys {
+		flag := flags.Lookup(key)
+		if flag == nil {
+			unknown = append(unknown, key)
+			continue
+		}
+
+		if flag.Changed {
+			continue
+		}
+
+		for _, item := range configItems(flag, config[key]) {
+			value, err := configValue(item)
+			if err != nil {
+				return fmt.Errorf("invalid value for %s in config %s: %s", key, name, err)
+			}
+
+			if err := flags.Set(key, value); err != nil {
+				return fmt.Errorf("invalid value for %s in config %s: %s", key, name, err)
+			}
+		}
+	}
+
+	// Warned about once all the flags are set so that verbose can come from the config as well
+	for _, key := range unknown {
+		printWarn(fmt.Sprintf("unknown key %s in config %s", key, name))
+	}
+
+	if Debug {
+		printDebug(fmt.Sprintf("Config: %s", name))
+	}
+
+	return nil
+}
+
+// Splits a config value into the values to set the flag with one at a time. Repeatable
+// flags such as --complexity-token are not split on commas so each item of a list is
+// set on its own, while every other flag is set once with the list joined by commas
+func configItems(flag *pflag.Flag, value interface{}) []interface{} {
+	if items, ok := value.([]interface{}); ok && flag.Value.Type() == "stringArray" {
+		return items
+	}
+
+	return []interface{}{value}
+}
+
+// Converts a value from the config into the string the flag would be given on the command
+// line where lists are comma separated and objects are comma separated key=value pairs
+func configValue(value interface{}) (string, error) {
+	switch v := value.(type) {
+	case string:
+		return v, nil
+	case bool:
+		return fmt.Sprintf("%t", v), nil
+	case json.Number:
+		return v.String(), nil
+	case []interface{}:
+		values := []string{}
+		for _, item := range v {
+			s, err := configValue(item)
+			if err != nil {
+				return "", err
+			}
+			values = append(values, s)
+		}
+		return strings.Join(values, ","), nil
+	case map[string]interface{}:
+		keys := []string{}
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		pairs := []string{}
+		for _, key := range keys {
+			s, err := configValue(v[key])
+			if err != nil {
+				return "", err
+			}
+			pairs = append(pairs, key+"="+s)
+		}
+		return strings.Join(pairs, ","), nil
+	}
+
+	return "", fmt.Errorf("unsupported value %v", value)
+}
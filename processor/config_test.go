@@ -0,0 +1,96 @@
+package processor
+
+import (
+	"github.com/spf13/pflag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestApplyConfig(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, DefaultConfigFile)
+	ioutil.WriteFile(name, []byte(`{
+	"sort": "code",
+	"format": "json",
+	"no-cocomo": true,
+	"max-workers": 8,
+	"exclude-dir": [".git", "vendor"],
+	"cocomo-weights": {"Go": 2},
+	"complexity-token": ["C:RETRY(a,b)", "Go:must("],
+	"exclude-path": ["vendor", "test"],
+	"unknown": "ignored"
+}`), 0600)
+
+	var sortBy, format string
+	var noCocomo bool
+	var maxWorkers int
+	var excludeDir []string
+	var weights map[string]string
+	var tokens []string
+	var excludePath []string
+
+	flags := pflag.NewFlagSet("scc", pflag.ContinueOnError)
+	flags.StringVar(&sortBy, "sort", "files", "")
+	flags.StringVar(&format, "format", "tabular", "")
+	flags.BoolVar(&noCocomo, "no-cocomo", false, "")
+	flags.IntVar(&maxWorkers, "max-workers", 0, "")
+	flags.StringSliceVar(&excludeDir, "exclude-dir", []string{".git"}, "")
+	flags.StringToStringVar(&weights, "cocomo-weights", map[string]string{}, "")
+	flags.StringArrayVar(&tokens, "complexity-token", []string{}, "")
+	flags.StringArrayVar(&excludePath, "exclude-path", []string{}, "")
+
+	// Set on the command line so the config must not override it
+	flags.Parse([]string{"--format", "csv"})
+
+	if err := applyConfig(flags, name, true); err != nil {
+		t.Fatalf("Expected no error got %s", err)
+	}
+
+	if sortBy != "code" || format != "csv" || !noCocomo || maxWorkers != 8 {
+		t.Errorf("Unexpected flags sort %s format %s no-cocomo %t max-workers %d", sortBy, format, noCocomo, maxWorkers)
+	}
+
+	if !reflect.DeepEqual(excludeDir, []string{".git", "vendor"}) || !reflect.DeepEqual(weights, map[string]string{"Go": "2"}) {
+		t.Errorf("Unexpected flags exclude-dir %v cocomo-weights %v", excludeDir, weights)
+	}
+
+	if !reflect.DeepEqual(tokens, []string{"C:RETRY(a,b)", "Go:must("}) {
+		t.Errorf("Unexpected flags complexity-token %v", tokens)
+	}
+
+	// Each item is its own expression rather than the list being joined into one
+	if !reflect.DeepEqual(excludePath, []string{"vendor", "test"}) {
+		t.Errorf("Unexpected flags exclude-path %v", excludePath)
+	}
+
+	if err := applyConfig(flags, filepath.Join(dir, "missing"), false); err != nil {
+		t.Errorf("Expected no error for missing default config got %s", err)
+	}
+
+	if err := applyConfig(flags, filepath.Join(dir, "missing"), true); err == nil {
+		t.Error("Expected error for missing config")
+	}
+
+	ioutil.WriteFile(name, []byte(`{"max-workers": "many"}`), 0600)
+	flags.Lookup("max-workers").Changed = false
+	if err := applyConfig(flags, name, true); err == nil {
+		t.Error("Expected error for invalid value")
+	}
+}
+
+func TestLoadConfigError(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	ConfigFile = filepath.Join(dir, "missing")
+	defer func() { ConfigFile = "" }()
+
+	if err := LoadConfig(pflag.NewFlagSet("scc", pflag.ContinueOnError)); err == nil {
+		t.Error("Expected error for missing config")
+	}
+}
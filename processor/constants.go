@@ -1,5 +1,5 @@
 package processor 
 
 const (
-languages = `ewogICJBQkFQIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJhYmFwIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIqIiwKICAgICAgIlxcXCIiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkFTUCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYXNhIiwKICAgICAgImFzcCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiJyIsCiAgICAgICJSRU0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkFTUC5ORVQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImFzYXgiLAogICAgICAiYXNjeCIsCiAgICAgICJhc214IiwKICAgICAgImFzcHgiLAogICAgICAibWFzdGVyIiwKICAgICAgInNpdGVtYXAiLAogICAgICAid2ViaW5mbyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdLAogICAgICBbCiAgICAgICAgIjwlLS0iLAogICAgICAgICItLT4iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJBVFMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAiIHRoZW4gIiwKICAgICAgIiBlbHNlICIsCiAgICAgICJjYXNlKyAiLAogICAgICAiaWZjYXNlIiwKICAgICAgImxldCAiLAogICAgICAiYW5kICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImRhdHMiLAogICAgICAic2F0cyIsCiAgICAgICJhdHMiLAogICAgICAiaGF0cyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXSwKICAgICAgWwogICAgICAgICIoKiIsCiAgICAgICAgIiopIgogICAgICBdLAogICAgICBbCiAgICAgICAgIi8vLy8iLAogICAgICAgICJUSElTU0hPVUxETkVWRVJBUFBFQVJXRUhPUEUiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJBV0siOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJhd2siCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkFjdGlvblNjcmlwdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYXMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJBZGEiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImFkYSIsCiAgICAgICJhZGIiLAogICAgICAiYWRzIiwKICAgICAgInBhZCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkFnZGEiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImFnZGEiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi0tIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgInstIiwKICAgICAgICAiLX0iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJBbGV4IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAieCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiQW5kcm9pZCBJbnRlcmZhY2UgRGVmaW5pdGlvbiBMYW5ndWFnZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYWlkbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoqIiwKICAgICAgICAiKi8iCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkFydm8iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJhdmRsIiwKICAgICAgImF2cHIiLAogICAgICAiYXZzYyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiQXNjaWlEb2MiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJhZG9jIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJBc3NlbWJseSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicyIsCiAgICAgICJhc20iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIjsiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0KICAgIF0KICB9LAogICJBdXRvSG90S2V5IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJhaGsiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIjsiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkF1dG9jb25mIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJpbiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIsCiAgICAgICJkbmwiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkJBU0giOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImJhc2giLAogICAgICAiLmJhc2hfbG9naW4iLAogICAgICAiYmFzaF9sb2dpbiIsCiAgICAgICIuYmFzaF9sb2dvdXQiLAogICAgICAiYmFzaF9sb2dvdXQiLAogICAgICAiLmJhc2hfcHJvZmlsZSIsCiAgICAgICJiYXNoX3Byb2ZpbGUiLAogICAgICAiLmJhc2hyYyIsCiAgICAgICJiYXNocmMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0KICAgIF0KICB9LAogICJCYXNpYyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAiZWxzZWlmICIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJiYXMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiciCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJCYXRjaCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYmF0IiwKICAgICAgImJ0bSIsCiAgICAgICJjbWQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIlJFTSIsCiAgICAgICI6OiIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiQmF6ZWwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImJ6bCIsCiAgICAgICJidWlsZC5iYXplbCIsCiAgICAgICJidWlsZCIsCiAgICAgICJ3b3Jrc3BhY2UiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkJpdGJha2UiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImJiIiwKICAgICAgImJiYXBwZW5kIiwKICAgICAgImJiY2xhc3MiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkJvbyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJpZiAiLAogICAgICAiZWxpZiAiLAogICAgICAidW5sZXNzICIsCiAgICAgICIgYW5kICIsCiAgICAgICJmb3IgIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ0ZXgiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiXCJcIlwiIiwKICAgICAgICAiXCJcIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiQnJhaW5mdWNrIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJbIiwKICAgICAgIl0iLAogICAgICAiPCIsCiAgICAgICI+IiwKICAgICAgIisiLAogICAgICAiLSIsCiAgICAgICIuIiwKICAgICAgIiwiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJiZiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiQnVpbGRTdHJlYW0iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJic3QiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImMiLAogICAgICAiZWMiLAogICAgICAicGdjIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiQyBIZWFkZXIiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImgiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJDIFNoZWxsIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjc2giLAogICAgICAiLmNzaHJjIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJDIyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY3MiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJDKysiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNjIiwKICAgICAgImNwcCIsCiAgICAgICJjeHgiLAogICAgICAiYysrIiwKICAgICAgInBjYyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkMrKyBIZWFkZXIiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImhoIiwKICAgICAgImhwcCIsCiAgICAgICJoeHgiLAogICAgICAiaW5sIiwKICAgICAgImlwcCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkNNYWtlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjbWFrZSIsCiAgICAgICJjbWFrZWxpc3RzLnR4dCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkNPQk9MIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjb2IiLAogICAgICAiY2JsIiwKICAgICAgImNjcCIsCiAgICAgICJjb2JvbCIsCiAgICAgICJjcHkiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIioiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkNTUyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY3NzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiQ1NWIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY3N2IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJDYWJhbCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY2FiYWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi0tIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgInstIiwKICAgICAgICAiLX0iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJDYXJnbyBMb2NrIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjYXJnby5sb2NrIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdLAogICAgICBbCiAgICAgICAgIlxcXCJcXFwiXFxcIiIsCiAgICAgICAgIlxcXCJcXFwiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICInJyciLAogICAgICAgICInJyciCiAgICAgIF0KICAgIF0KICB9LAogICJDYXNzaXVzIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjYXNzaXVzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiQ2V5bG9uIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjZXlsb24iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJDbG9qdXJlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjbGoiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIjsiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkNsb2p1cmVTY3JpcHQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNsanMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIjsiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkNsb3N1cmUgVGVtcGxhdGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIsCiAgICAgICI+PSAiLAogICAgICAiPD0gIiwKICAgICAgIj86ICIsCiAgICAgICI/IDogIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic295IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKioiLAogICAgICAgICIqLyIKICAgICAgXSwKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiQ29mZmVlU2NyaXB0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjb2ZmZWUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiIyMjIiwKICAgICAgICAiIyMjIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICInIiwKICAgICAgICAiJyIKICAgICAgXQogICAgXQogIH0sCiAgIkNvZ2VudCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY29nZW50IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiQ29sZEZ1c2lvbiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY2ZtIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIjwhLS0tIiwKICAgICAgICAiLS0tPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0KICAgIF0KICB9LAogICJDb2xkRnVzaW9uIENGU2NyaXB0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjZmMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJDb3EiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInYiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiKCoiLAogICAgICAgICIqKSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkNyZW9sZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNyZW9sZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiQ3J5c3RhbCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY3IiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0KICAgIF0KICB9LAogICJDeXRob24iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgImFuZCAiLAogICAgICAib3IgIiwKICAgICAgIm5vdCAiLAogICAgICAiaW4gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicHl4IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdLAogICAgICBbCiAgICAgICAgIlxcXCJcXFwiXFxcIiIsCiAgICAgICAgIlxcXCJcXFwiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICInJyciLAogICAgICAgICInJyciCiAgICAgIF0KICAgIF0KICB9LAogICJEIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJkIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdLAogICAgICBbCiAgICAgICAgIi8rIiwKICAgICAgICAiKy8iCiAgICAgIF0KICAgIF0sCiAgICAibmVzdGVkbXVsdGlsaW5lIjogdHJ1ZSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICInIiwKICAgICAgICAiJyIKICAgICAgXQogICAgXQogIH0sCiAgIkRhcnQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImRhcnQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJEZXZpY2UgVHJlZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZHRzIiwKICAgICAgImR0c2kiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJEaGFsbCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImRoYWxsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJ7LSIsCiAgICAgICAgIi19IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiRG9ja2VyZmlsZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZG9ja2VyZmlsZSIsCiAgICAgICJkb2NrZXJpZ25vcmUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0KICAgIF0KICB9LAogICJEb2N1bWVudCBUeXBlIERlZmluaXRpb24iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJkdGQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkVsaXhpciI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZXgiLAogICAgICAiZXhzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCJcXFwiXFxcIiIsCiAgICAgICAgIlxcXCJcXFwiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICInJyciLAogICAgICAgICInJyciCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0KICAgIF0KICB9LAogICJFbG0iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImVsbSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiey0iLAogICAgICAgICItfSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkVtYWNzIERldiBFbnYiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImVkZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiRW1hY3MgTGlzcCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIjsiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkVybGFuZyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZXJsIiwKICAgICAgImhybCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiJSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiRXhwZWN0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJleHAiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0KICAgIF0KICB9LAogICJFeHRlbnNpYmxlIFN0eWxlc2hlZXQgTGFuZ3VhZ2UgVHJhbnNmb3JtYXRpb25zIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAieHNsdCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiRiMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImZzIiwKICAgICAgImZzaSIsCiAgICAgICJmc3giLAogICAgICAiZnNzY3JpcHQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIigqIiwKICAgICAgICAiKikiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJGKiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZnN0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIigqIiwKICAgICAgICAiKikiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJGSURMIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJmaWRsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiRk9SVFJBTiBMZWdhY3kiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImYiLAogICAgICAiZm9yIiwKICAgICAgImZ0biIsCiAgICAgICJmNzciLAogICAgICAicGZvIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICJjIiwKICAgICAgIkMiLAogICAgICAiISIsCiAgICAgICIqIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdCiAgICBdCiAgfSwKICAiRk9SVFJBTiBNb2Rlcm4iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImYwMyIsCiAgICAgICJmMDgiLAogICAgICAiZjkwIiwKICAgICAgImY5NSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiISIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkZpc2giOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImZpc2giCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0KICAgIF0KICB9LAogICJGb3J0aCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiNHRoIiwKICAgICAgImZvcnRoIiwKICAgICAgImZyIiwKICAgICAgImZydCIsCiAgICAgICJmdGgiLAogICAgICAiZjgzIiwKICAgICAgImZiIiwKICAgICAgImZwbSIsCiAgICAgICJlNCIsCiAgICAgICJyeCIsCiAgICAgICJmdCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiXFxcXCIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIoICIsCiAgICAgICAgIikiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJGcmFnbWVudCBTaGFkZXIgRmlsZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZnNoIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiRnJlZW1hcmtlciBUZW1wbGF0ZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiPCNsaXN0ICIsCiAgICAgICI8I2Fzc2lnbiAiLAogICAgICAiPCNpZiAiLAogICAgICAiPCNlbHNlaWYgIiwKICAgICAgIjwjZWxzZT4iLAogICAgICAiPCNlbHNlPiAiLAogICAgICAiPCNzd2l0Y2ggICIsCiAgICAgICI8I2Nhc2UgIiwKICAgICAgIjwjZGVmYXVsdD4iLAogICAgICAiPCNkZWZhdWx0PiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImZ0bCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8Iy0tIiwKICAgICAgICAiLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiR0RTY3JpcHQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImdkIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdLAogICAgICBbCiAgICAgICAgIlxcXCJcXFwiXFxcIiIsCiAgICAgICAgIlxcXCJcXFwiXFxcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkdMU0wiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInZlcnQiLAogICAgICAidGVzYyIsCiAgICAgICJ0ZXNlIiwKICAgICAgImdlb20iLAogICAgICAiZnJhZyIsCiAgICAgICJjb21wIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiR04iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImlmKCIsCiAgICAgICJpZiAoIiwKICAgICAgImVsc2UgaWYoIiwKICAgICAgImVsc2UgaWYgKCIsCiAgICAgICJlbHNlKCIsCiAgICAgICJlbHNlICgiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZ24iLAogICAgICAiZ25pIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJHYW1lIE1ha2VyIExhbmd1YWdlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAib3IgIiwKICAgICAgIiYmICIsCiAgICAgICJhbmQgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJnbWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIiwKICAgICAgIi8vLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiR2FtZSBNYWtlciBQcm9qZWN0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAieXlwIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJHaGVya2luIFNwZWNpZmljYXRpb24iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImdpdmVuIiwKICAgICAgIndoZW4iLAogICAgICAidGhlbiIsCiAgICAgICJhbmQiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJmZWF0dXJlIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJHbyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZ28iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiYCIsCiAgICAgICAgImAiCiAgICAgIF0KICAgIF0KICB9LAogICJHbyBUZW1wbGF0ZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAie3tpZiAiLAogICAgICAie3sgaWYgIiwKICAgICAgInt7ZWxzZSIsCiAgICAgICJ7eyBlbHNlIiwKICAgICAgInt7cmFuZ2UgIiwKICAgICAgInt7IHJhbmdlICIsCiAgICAgICJ7e3dpdGgiLAogICAgICAie3sgd2l0aCIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRtcGwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAie3svKiIsCiAgICAgICAgIiovfX0iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJHcmFkbGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJncmFkbGUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdLAogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJHcm9vdnkiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImdyb292eSIsCiAgICAgICJncnQiLAogICAgICAiZ3RwbCIsCiAgICAgICJndnkiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJIRVgiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJoZXgiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkhUTUwiOiB7CiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImh0bWwiLAogICAgICAiaHRtIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIjwhLS0iLAogICAgICAgICItLT4iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJIYW1sZXQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImhhbWxldCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiSGFuZGxlYmFycyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaGJzIiwKICAgICAgImhhbmRsZWJhcnMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXSwKICAgICAgWwogICAgICAgICJ7eyEiLAogICAgICAgICJ9fSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0KICAgIF0KICB9LAogICJIYXBweSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInkiLAogICAgICAibHkiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkhhc2tlbGwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImhzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJ7LSIsCiAgICAgICAgIi19IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiSGF4ZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaHgiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJJREwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImlkbCIsCiAgICAgICJ3ZWJpZGwiLAogICAgICAid2lkbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIklkcmlzIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJpZHIiLAogICAgICAibGlkciIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiey0iLAogICAgICAgICItfSIKICAgICAgXQogICAgXSwKICAgICJuZXN0ZWRtdWx0aWxpbmUiOiB0cnVlLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICJcXFwiXFxcIlxcXCIiLAogICAgICAgICJcXFwiXFxcIlxcXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJJbnRlbCBIRVgiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJpaGV4IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJJc2FiZWxsZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidGh5IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJ7KiIsCiAgICAgICAgIip9IgogICAgICBdLAogICAgICBbCiAgICAgICAgIigqIiwKICAgICAgICAiKikiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAi4oC5IiwKICAgICAgICAi4oC6IgogICAgICBdLAogICAgICBbCiAgICAgICAgIlxcXFw8b3Blbj4iLAogICAgICAgICJcXFxcPGNsb3NlPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiJyciLAogICAgICAgICInJyIKICAgICAgXQogICAgXQogIH0sCiAgIkpBSSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiamFpIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgIm5lc3RlZG11bHRpbGluZSI6IHRydWUsCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJKU09OIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAianNvbiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiSlNPTkwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJqc29ubCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiSlNYIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJqc3giCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJKYWRlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJpZiAiLAogICAgICAiZWxzZSBpZiAiLAogICAgICAidW5sZXNzICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImphZGUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiSmF2YSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiamF2YSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkphdmFTY3JpcHQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImpzIiwKICAgICAgIm1qcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkphdmFTZXJ2ZXIgUGFnZXMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImpzcCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkplbmtpbnMgQnVpbGRmaWxlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiamVua2luc2ZpbGUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkppbmphIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJ7JSBmb3IgIiwKICAgICAgInslLSBmb3IgIiwKICAgICAgInslIGlmICIsCiAgICAgICJ7JS0gaWYgIiwKICAgICAgInslIGVsc2UgIiwKICAgICAgInslLSBlbHNlICIsCiAgICAgICJ7JSBlbGlmICIsCiAgICAgICJ7JSBtYWNybyAiLAogICAgICAieyUtIG1hY3JvICIsCiAgICAgICJ7JSBjYWxsICIsCiAgICAgICJ7JS0gY2FsbCAiLAogICAgICAieyUgZmlsdGVyICIsCiAgICAgICJ7JS0gZmlsdGVyICIsCiAgICAgICJ7JSBzZXQgIiwKICAgICAgInslIGluY2x1ZGUgIiwKICAgICAgInslIGZyb20gIiwKICAgICAgInslIGV4dGVuZHMgIiwKICAgICAgInslIHdpdGggIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiamluamEiLAogICAgICAiajIiLAogICAgICAiamluamEyIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgInsjIiwKICAgICAgICAiI30iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJKdWxpYSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiamwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiIz0iLAogICAgICAgICI9IyIKICAgICAgXQogICAgXSwKICAgICJuZXN0ZWRtdWx0aWxpbmUiOiB0cnVlLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICJcXFwiXFxcIlxcXCIiLAogICAgICAgICJcXFwiXFxcIlxcXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJKdWxpdXMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImp1bGl1cyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkp1cHl0ZXIiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJpcHluYiIsCiAgICAgICJqcHluYiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiSnVzdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAianVzdGZpbGUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJLb3JuIFNoZWxsIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJrc2giLAogICAgICAiLmtzaHJjIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdCiAgICBdCiAgfSwKICAiS290bGluIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJrdCIsCiAgICAgICJrdHMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAibmVzdGVkbXVsdGlsaW5lIjogdHJ1ZSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkxEIFNjcmlwdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibGRzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiTEVTUyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImxlc3MiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJMRVgiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJMT0xDT0RFIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJBV1NVTSBUSFggIiwKICAgICAgIk8gTk9FUyAiLAogICAgICAiUExaIE9QRU4gRklMRSAiLAogICAgICAiSU0gSU4gWVIgIiwKICAgICAgIk8gUkxZPyIsCiAgICAgICJPIFJMWT8gIiwKICAgICAgIldURj8iLAogICAgICAiV1RGPyAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJsb2wiLAogICAgICAibG9scyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiQlRXIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIk9CVFciLAogICAgICAgICJUTERSIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiTGFUZVgiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ0ZXgiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiUiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkxlYW4iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImxlYW4iLAogICAgICAiaGxlYW4iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi0tIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8tIiwKICAgICAgICAiLS8iCiAgICAgIF0KICAgIF0sCiAgICAibmVzdGVkbXVsdGlsaW5lIjogdHJ1ZSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkxpY2Vuc2UiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJsaWNlbnNlIiwKICAgICAgImxpY2VuY2UiLAogICAgICAiY29weWluZyIsCiAgICAgICJjb3B5aW5nMyIsCiAgICAgICJ1bmxpY2Vuc2UiLAogICAgICAidW5saWNlbmNlIiwKICAgICAgImxpY2Vuc2UtbWl0IiwKICAgICAgImxpY2VuY2UtbWl0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJMaXNwIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJsaXNwIiwKICAgICAgImxzcCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIjfCIsCiAgICAgICAgInwjIgogICAgICBdCiAgICBdLAogICAgIm5lc3RlZG11bHRpbGluZSI6IHRydWUsCiAgICAicXVvdGVzIjogW10KICB9LAogICJMdWEiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImx1YSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLS1bWyIsCiAgICAgICAgIl1dIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICInIiwKICAgICAgICAiJyIKICAgICAgXQogICAgXQogIH0sCiAgIkx1Y2l1cyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibHVjaXVzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiTVFMIEhlYWRlciI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibXFoIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiTVFMNCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibXE0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiTVFMNSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibXE1IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiTVNCdWlsZCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY3Nwcm9qIiwKICAgICAgInZicHJvaiIsCiAgICAgICJmc3Byb2oiLAogICAgICAicHJvcHMiLAogICAgICAidGFyZ2V0cyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiTVVNUFMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm1wcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiTWFjcm9tZWRpYSBlWHRlbnNpYmxlIE1hcmt1cCBMYW5ndWFnZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm14bWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIk1hZGxhbmciOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm1hZCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJ7IyIsCiAgICAgICAgIiN9IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiTWFrZWZpbGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm1ha2VmaWxlIiwKICAgICAgIm1hayIsCiAgICAgICJtayIsCiAgICAgICJicCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiTWFrbyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiJSBmb3IgIiwKICAgICAgIiUgaWYgIiwKICAgICAgIiUgZWxzZSAiLAogICAgICAiJSBlbGlmICIsCiAgICAgICI8JSBpbmNsdWRlICIsCiAgICAgICI8JWRlZiAiLAogICAgICAiPCVwYWdlICIsCiAgICAgICI8JWRlZiAiLAogICAgICAiPCVibG9jayAiLAogICAgICAiPCVuYW1lc3BhY2UgIiwKICAgICAgIjwlaW5oZXJpdCAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJtYWtvIiwKICAgICAgIm1hbyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCVkb2M+IiwKICAgICAgICAiPC8lZG9jPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIk1hcmtkb3duIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibWQiLAogICAgICAibWFya2Rvd24iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIk1lc29uIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3JlYWNoICIsCiAgICAgICJpZiAiLAogICAgICAiZWxpZiAiLAogICAgICAidW5sZXNzICIsCiAgICAgICJhbmQgIiwKICAgICAgIm9yICIsCiAgICAgICJlbHNlICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm1lc29uLmJ1aWxkIiwKICAgICAgIm1lc29uX29wdGlvbnMudHh0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJycnIiwKICAgICAgICAiJycnIgogICAgICBdCiAgICBdCiAgfSwKICAiTW9kdWxhMyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibTMiLAogICAgICAibWciLAogICAgICAiaWciLAogICAgICAiaTMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiKCoiLAogICAgICAgICIqKSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIk1vZHVsZS1EZWZpbml0aW9uIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJkZWYiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIjsiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIk11c3RhY2hlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJtdXN0YWNoZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJ7eyEiLAogICAgICAgICJ9fSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0KICAgIF0KICB9LAogICJOaW0iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm5pbSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICJcXFwiXFxcIlxcXCIiLAogICAgICAgICJcXFwiXFxcIlxcXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJOaXgiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm5peCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIk9DYW1sIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJtbCIsCiAgICAgICJtbGkiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiKCoiLAogICAgICAgICIqKSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIk9iamVjdGl2ZSBDIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJtIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiT2JqZWN0aXZlIEMrKyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibW0iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJPcGFsYW5nIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJvcGEiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJPcmciOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm9yZyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyAiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIk96IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJveiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiJSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiUEhQIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJwaHAiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiLAogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0KICAgIF0KICB9LAogICJQS0dCVUlMRCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInBrZ2J1aWxkIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJQU0wgQXNzZXJ0aW9uIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJwc2wiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJQYXNjYWwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInBhcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJ7IiwKICAgICAgICAifSIKICAgICAgXSwKICAgICAgWwogICAgICAgICIoKiIsCiAgICAgICAgIiopIgogICAgICBdLAogICAgICBbCiAgICAgICAgInsiLAogICAgICAgICIqKSIKICAgICAgXSwKICAgICAgWwogICAgICAgICIoKiIsCiAgICAgICAgIn0iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdCiAgICBdCiAgfSwKICAiUGF0Y2giOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJwYXRjaCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiUGVybCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicGwiLAogICAgICAicG0iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPXBvZCIsCiAgICAgICAgIj1jdXQiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdCiAgICBdCiAgfSwKICAiUGxhaW4gVGV4dCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRleHQiLAogICAgICAidHh0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJQb2xseSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicG9sbHkiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIlBvd2Vyc2hlbGwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgIndoaWxlICIsCiAgICAgICJ3aGlsZSgiLAogICAgICAidW50aWwgIiwKICAgICAgInVudGlsKCIsCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiZm9yZWFjaCAiLAogICAgICAiZm9yZWFjaCgiLAogICAgICAiaWYgIiwKICAgICAgImVsc2VpZiAiLAogICAgICAiZWxzZSAiLAogICAgICAic3dpdGNoIiwKICAgICAgInN3aXRjaCgiLAogICAgICAiLWd0IiwKICAgICAgIi1sdCIsCiAgICAgICItZXEiLAogICAgICAiLW5lIiwKICAgICAgIi1nZSIsCiAgICAgICItbGUiLAogICAgICAiLWluIiwKICAgICAgIi1ub3RpbiIsCiAgICAgICItY29udGFpbnMiLAogICAgICAiLW5vdGNvbnRhaW5zIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicHMxIiwKICAgICAgInBzbTEiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCMiLAogICAgICAgICIjPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIlByb2Nlc3NpbmciOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInBkZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIlByb2xvZyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicCIsCiAgICAgICJwcm8iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiUiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIlByb3BlcnRpZXMgRmlsZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInByb3BlcnRpZXMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlByb3RvY29sIEJ1ZmZlcnMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInByb3RvIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiUHVwcGV0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJwcCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI9YmVnaW4iLAogICAgICAgICI9ZW5kIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICInIiwKICAgICAgICAiJyIKICAgICAgXQogICAgXQogIH0sCiAgIlB1cmVTY3JpcHQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInB1cnMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi0tIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgInstIiwKICAgICAgICAiLX0iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJQeXRob24iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgImFuZCAiLAogICAgICAib3IgIiwKICAgICAgIm5vdCAiLAogICAgICAiaW4gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicHkiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiXFxcIlxcXCJcXFwiIiwKICAgICAgICAiXFxcIlxcXCJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIicnJyIsCiAgICAgICAgIicnJyIKICAgICAgXQogICAgXQogIH0sCiAgIlFDTCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicWNsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiUU1MIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJxbWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJSIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJyIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJSYWtlZmlsZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicmFrZSIsCiAgICAgICJyYWtlZmlsZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI9YmVnaW4iLAogICAgICAgICI9ZW5kIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICInIiwKICAgICAgICAiJyIKICAgICAgXQogICAgXQogIH0sCiAgIlJhem9yIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjc2h0bWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXSwKICAgICAgWwogICAgICAgICJAKiIsCiAgICAgICAgIipAIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiUmVTdHJ1Y3R1cmVkVGV4dCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInJzdCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiUmVwb3J0IERlZmluaXRpb24gTGFuZ3VhZ2UiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJyZGwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIlJvYm90IEZyYW1ld29yayI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInJvYm90IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJSdWJ5IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJyYiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI9YmVnaW4iLAogICAgICAgICI9ZW5kIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICInIiwKICAgICAgICAiJyIKICAgICAgXQogICAgXQogIH0sCiAgIlJ1YnkgSFRNTCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicmh0bWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIlJ1c3QiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInJzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgIm5lc3RlZG11bHRpbGluZSI6IHRydWUsCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJTQVMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImRvIiwKICAgICAgIiVkbyIsCiAgICAgICJpZiIsCiAgICAgICIlaWYiLAogICAgICAiZWxzZSIsCiAgICAgICIlZWxzZSIsCiAgICAgICJjYXNlIiwKICAgICAgIm9yIiwKICAgICAgImFuZCIsCiAgICAgICJePSIsCiAgICAgICLCrD0iLAogICAgICAifj0iLAogICAgICAibmUiLAogICAgICAiZXEiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzYXMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIioiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICInIiwKICAgICAgICAiJyIKICAgICAgXQogICAgXQogIH0sCiAgIlNLSUxMIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJpbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiU1BEWCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInNwZHgiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlNRTCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic3FsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICInIiwKICAgICAgICAiJyIKICAgICAgXQogICAgXQogIH0sCiAgIlNSZWNvZGUgVGVtcGxhdGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInNydCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOzsiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlNWRyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic3ZnIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIjwhLS0iLAogICAgICAgICItLT4iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJTYXNzIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzYXNzIiwKICAgICAgInNjc3MiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJTY2FsYSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic2MiLAogICAgICAic2NhbGEiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJTY2hlbWUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInNjbSIsCiAgICAgICJzcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIjfCIsCiAgICAgICAgInwjIgogICAgICBdCiAgICBdLAogICAgIm5lc3RlZG11bHRpbGluZSI6IHRydWUsCiAgICAicXVvdGVzIjogW10KICB9LAogICJTY29ucyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY3NpZyIsCiAgICAgICJzY29uc3RydWN0IiwKICAgICAgInNjb25zY3JpcHQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiXFxcIlxcXCJcXFwiIiwKICAgICAgICAiXFxcIlxcXCJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIicnJyIsCiAgICAgICAgIicnJyIKICAgICAgXQogICAgXQogIH0sCiAgIlNoZWxsIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzaCIsCiAgICAgICIudGNzaHJjIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdCiAgICBdCiAgfSwKICAiU21hcnR5IFRlbXBsYXRlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiLAogICAgICAiaW5jbHVkZSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ0cGwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAieyoiLAogICAgICAgICIqfSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlNvZnRicmlkZ2UgQmFzaWMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgImVsc2VpZiAiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic2JsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICInIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdCiAgICBdCiAgfSwKICAiU3BlY21hbiBlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJlIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItLSIsCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICInPiIsCiAgICAgICAgIjwnIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiU3BpY2UgTmV0bGlzdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY2t0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIqIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJTdGFuZGFyZCBNTCAoU01MKSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic21sIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIigqIiwKICAgICAgICAiKikiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJTdGF0YSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yZWFjaCIsCiAgICAgICJmb3J2YWx1ZXMiLAogICAgICAiaWYiLAogICAgICAiZWxzZSIsCiAgICAgICJ3aGlsZSIsCiAgICAgICJzd2l0Y2giLAogICAgICAifCIsCiAgICAgICImIiwKICAgICAgIiE9IiwKICAgICAgIj09IgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZG8iLAogICAgICAiYWRvIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIsCiAgICAgICIqIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiYFwiIiwKICAgICAgICAiXCInIgogICAgICBdCiAgICBdCiAgfSwKICAiU3R5bHVzIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImlmICIsCiAgICAgICJ1bmxlc3MgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInN0eWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJTd2lmdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic3dpZnQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAibmVzdGVkbXVsdGlsaW5lIjogdHJ1ZSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIlN3aWciOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJpIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJTeXN0ZW1WZXJpbG9nIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8ICIsCiAgICAgICImICIsCiAgICAgICIhPSAiLAogICAgICAiIT09ICIsCiAgICAgICI9PSAiLAogICAgICAiZm9yZWFjaCAiLAogICAgICAiZm9yZWFjaCgiLAogICAgICAiY2FzZSAiLAogICAgICAiY2FzZSgiLAogICAgICAiY2FzZXggIiwKICAgICAgImNhc2V4KCIsCiAgICAgICJjYXNleiAiLAogICAgICAiY2FzZXooIiwKICAgICAgImNhc2V4eiAiLAogICAgICAiY2FzZXh6KCIsCiAgICAgICJmb3JrICIsCiAgICAgICIgPyAiLAogICAgICAiaW5zaWRlIiwKICAgICAgIndpdGgiLAogICAgICAiZXZlbnQgIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic3YiLAogICAgICAic3ZoIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiU3lzdGVtZCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImF1dG9tb3VudCIsCiAgICAgICJkZXZpY2UiLAogICAgICAibGluayIsCiAgICAgICJtb3VudCIsCiAgICAgICJwYXRoIiwKICAgICAgInNjb3BlIiwKICAgICAgInNlcnZpY2UiLAogICAgICAic2xpY2UiLAogICAgICAic29ja2V0IiwKICAgICAgInN3YXAiLAogICAgICAidGFyZ2V0IiwKICAgICAgInRpbWVyIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJUQ0wiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRjbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICInIiwKICAgICAgICAiJyIKICAgICAgXQogICAgXQogIH0sCiAgIlRPTUwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRvbWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiXFxcIlxcXCJcXFwiIiwKICAgICAgICAiXFxcIlxcXCJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIicnJyIsCiAgICAgICAgIicnJyIKICAgICAgXQogICAgXQogIH0sCiAgIlRhc2tQYXBlciI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRhc2twYXBlciIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiVGVYIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ0ZXgiLAogICAgICAic3R5IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIlIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJUaHJpZnQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ0aHJpZnQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIiwKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICInIiwKICAgICAgICAiJyIKICAgICAgXQogICAgXQogIH0sCiAgIlR3aWcgVGVtcGxhdGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgInslIGZvciAiLAogICAgICAieyUgaWYgIiwKICAgICAgInslIGVsc2UgIiwKICAgICAgInslIGVsc2VpZiAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ0d2lnIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJUeXBlU2NyaXB0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8ICIsCiAgICAgICJ8fCAiLAogICAgICAiJiAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICIhPT0gIiwKICAgICAgIj09ICIsCiAgICAgICI9PT0gIiwKICAgICAgImZvcmVhY2goIiwKICAgICAgImNhc2UgIiwKICAgICAgImNhc2UoIiwKICAgICAgImNhc2V4ICIsCiAgICAgICJjYXNleCgiLAogICAgICAiY2FzZXogIiwKICAgICAgImNhc2V6KCIsCiAgICAgICJjYXNleHogIiwKICAgICAgImNhc2V4eigiLAogICAgICAiZm9yayIsCiAgICAgICI/IiwKICAgICAgIjoiLAogICAgICAiaW5zaWRlIiwKICAgICAgIndpdGgiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ0cyIsCiAgICAgICJ0c3giCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJUeXBlU2NyaXB0IFR5cGluZ3MiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInwgIiwKICAgICAgInx8ICIsCiAgICAgICImICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIiE9PSAiLAogICAgICAiPT0gIiwKICAgICAgIj09PSAiLAogICAgICAiZm9yZWFjaCgiLAogICAgICAiY2FzZSAiLAogICAgICAiY2FzZSgiLAogICAgICAiY2FzZXggIiwKICAgICAgImNhc2V4KCIsCiAgICAgICJjYXNleiAiLAogICAgICAiY2FzZXooIiwKICAgICAgImNhc2V4eiAiLAogICAgICAiY2FzZXh6KCIsCiAgICAgICJmb3JrIiwKICAgICAgIj8iLAogICAgICAiOiIsCiAgICAgICJpbnNpZGUiLAogICAgICAid2l0aCIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImQudHMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJVbnJlYWwgU2NyaXB0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ1YyIsCiAgICAgICJ1Y2kiLAogICAgICAidXBrZyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIlVyL1dlYiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidXIiLAogICAgICAidXJzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIigqIiwKICAgICAgICAiKikiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJVci9XZWIgUHJvamVjdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidXJwIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJWSERMIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ2aGQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi0tIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJWYWxhIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ2YWxhIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiVmFybmlzaCBDb25maWd1cmF0aW9uIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidmNsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIiwKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJWZXJpbG9nIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ2ZyIsCiAgICAgICJ2aCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIlZlcmlsb2cgQXJncyBGaWxlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaXJ1bmFyZ3MiLAogICAgICAieHJ1bmFyZ3MiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlZlcnRleCBTaGFkZXIgRmlsZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidnNoIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiVmltIFNjcmlwdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidmltIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICJcXFwiIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdCiAgICBdCiAgfSwKICAiVmlzdWFsIEJhc2ljIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJlbHNlaWYgIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInZiIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICInIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdCiAgICBdCiAgfSwKICAiVnVlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ2dWUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIldvbGZyYW0iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm5iIiwKICAgICAgIndsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIigqIiwKICAgICAgICAiKikiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJYQU1MIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ4YW1sIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIjwhLS0iLAogICAgICAgICItLT4iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJYQ29kZSBDb25maWciOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ4Y2NvbmZpZyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlhNTCI6IHsKICAgICJleHRlbnNpb25zIjogWwogICAgICAieG1sIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIjwhLS0iLAogICAgICAgICItLT4iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJYTUwgU2NoZW1hIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAieHNkIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJYdGVuZCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAieHRlbmQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJZQU1MIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAieWFtbCIsCiAgICAgICJ5bWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlppZyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAid2hpbGUgIiwKICAgICAgImZvciAiLAogICAgICAiaWYgIiwKICAgICAgImVsc2UgIiwKICAgICAgImRlZmVyICIsCiAgICAgICJzd2l0Y2giLAogICAgICAifHwiLAogICAgICAiJiYiLAogICAgICAiIT0iLAogICAgICAiPT0iCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ6aWciCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiXFxcXCIsCiAgICAgICAgIlxuIgogICAgICBdCiAgICBdCiAgfSwKICAiWnNoIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ6c2giLAogICAgICAiLnpzaGVudiIsCiAgICAgICJ6c2hlbnYiLAogICAgICAiLnpsb2dpbiIsCiAgICAgICJ6bG9naW4iLAogICAgICAiLnpsb2dvdXQiLAogICAgICAiemxvZ291dCIsCiAgICAgICIuenByb2ZpbGUiLAogICAgICAienByb2ZpbGUiLAogICAgICAiLnpzaHJjIiwKICAgICAgInpzaHJjIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdCiAgICBdCiAgfSwKICAiZ2l0aWdub3JlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiLmdpdGlnbm9yZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAibTQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJtNCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAibnVzcGVjIjogewogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJudXNwZWMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgInNlZCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAiYW5kICIsCiAgICAgICJvciAiLAogICAgICAibm90ICIsCiAgICAgICJpbiAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzZWQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0KfQ==`
+languages = `ewogICJBQkFQIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJhYmFwIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIqIiwKICAgICAgIlxcXCIiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkFTUCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYXNhIiwKICAgICAgImFzcCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiJyIsCiAgICAgICJSRU0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkFTUC5ORVQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImFzYXgiLAogICAgICAiYXNjeCIsCiAgICAgICJhc214IiwKICAgICAgImFzcHgiLAogICAgICAibWFzdGVyIiwKICAgICAgInNpdGVtYXAiLAogICAgICAid2ViaW5mbyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdLAogICAgICBbCiAgICAgICAgIjwlLS0iLAogICAgICAgICItLT4iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJBVFMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAiIHRoZW4gIiwKICAgICAgIiBlbHNlICIsCiAgICAgICJjYXNlKyAiLAogICAgICAiaWZjYXNlIiwKICAgICAgImxldCAiLAogICAgICAiYW5kICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImRhdHMiLAogICAgICAic2F0cyIsCiAgICAgICJhdHMiLAogICAgICAiaGF0cyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXSwKICAgICAgWwogICAgICAgICIoKiIsCiAgICAgICAgIiopIgogICAgICBdLAogICAgICBbCiAgICAgICAgIi8vLy8iLAogICAgICAgICJUSElTU0hPVUxETkVWRVJBUFBFQVJXRUhPUEUiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJBV0siOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJhd2siCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXSwKICAgICJzaGViYW5ncyI6IFsKICAgICAgImF3ayIsCiAgICAgICJnYXdrIiwKICAgICAgIm1hd2siCiAgICBdCiAgfSwKICAiQWN0aW9uU2NyaXB0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJhcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkFkYSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYWRhIiwKICAgICAgImFkYiIsCiAgICAgICJhZHMiLAogICAgICAicGFkIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiQWdkYSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYWdkYSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiey0iLAogICAgICAgICItfSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkFsZXgiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ4IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJBbmRyb2lkIEludGVyZmFjZSBEZWZpbml0aW9uIExhbmd1YWdlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJhaWRsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKioiLAogICAgICAgICIqLyIKICAgICAgXSwKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiQXJ2byI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImF2ZGwiLAogICAgICAiYXZwciIsCiAgICAgICJhdnNjIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJBc2NpaURvYyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImFkb2MiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkFzc2VtYmx5IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzIiwKICAgICAgImFzbSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICInIiwKICAgICAgICAiJyIKICAgICAgXQogICAgXQogIH0sCiAgIkF1dG9Ib3RLZXkiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImFoayIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiQXV0b2NvbmYiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImluIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIiwKICAgICAgImRubCIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiQkFTSCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYmFzaCIsCiAgICAgICIuYmFzaF9sb2dpbiIsCiAgICAgICJiYXNoX2xvZ2luIiwKICAgICAgIi5iYXNoX2xvZ291dCIsCiAgICAgICJiYXNoX2xvZ291dCIsCiAgICAgICIuYmFzaF9wcm9maWxlIiwKICAgICAgImJhc2hfcHJvZmlsZSIsCiAgICAgICIuYmFzaHJjIiwKICAgICAgImJhc2hyYyIKICAgIF0sCiAgICAiaGVyZWRvY3MiOiBbCiAgICAgICI8PCIsCiAgICAgICI8PC0iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0KICAgIF0sCiAgICAic2hlYmFuZ3MiOiBbCiAgICAgICJiYXNoIgogICAgXQogIH0sCiAgIkJhc2ljIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJlbHNlaWYgIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImJhcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiJyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkJhdGNoIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJiYXQiLAogICAgICAiYnRtIiwKICAgICAgImNtZCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiUkVNIiwKICAgICAgIjo6IgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJCYXplbCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYnpsIiwKICAgICAgImJ1aWxkLmJhemVsIiwKICAgICAgImJ1aWxkIiwKICAgICAgIndvcmtzcGFjZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiQml0YmFrZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYmIiLAogICAgICAiYmJhcHBlbmQiLAogICAgICAiYmJjbGFzcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiQm9vIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImlmICIsCiAgICAgICJlbGlmICIsCiAgICAgICJ1bmxlc3MgIiwKICAgICAgIiBhbmQgIiwKICAgICAgImZvciAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRleCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICInIiwKICAgICAgICAiJyIKICAgICAgXSwKICAgICAgWwogICAgICAgICJcIlwiXCIiLAogICAgICAgICJcIlwiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJCcmFpbmZ1Y2siOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgIlsiLAogICAgICAiXSIsCiAgICAgICI8IiwKICAgICAgIj4iLAogICAgICAiKyIsCiAgICAgICItIiwKICAgICAgIi4iLAogICAgICAiLCIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImJmIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJCdWlsZFN0cmVhbSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImJzdCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiQyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYyIsCiAgICAgICJlYyIsCiAgICAgICJwZ2MiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJDIEhlYWRlciI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkMgU2hlbGwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNzaCIsCiAgICAgICIuY3NocmMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXSwKICAgICJzaGViYW5ncyI6IFsKICAgICAgImNzaCIsCiAgICAgICJ0Y3NoIgogICAgXQogIH0sCiAgIkMjIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXSwKICAgICJ0ZXN0X2ZpbGVzIjogWwogICAgICAiKlRlc3QuY3MiLAogICAgICAiKlRlc3RzLmNzIgogICAgXSwKICAgICJ2ZXJiYXRpbV9lc2NhcGUiOiAiXCIiLAogICAgInZlcmJhdGltX3ByZWZpeGVzIjogWwogICAgICAiQCIsCiAgICAgICIkQCIsCiAgICAgICJAJCIKICAgIF0KICB9LAogICJDKysiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNjIiwKICAgICAgImNwcCIsCiAgICAgICJjeHgiLAogICAgICAiYysrIiwKICAgICAgInBjYyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXSwKICAgICJyYXdfc3RyaW5nX3ByZWZpeGVzIjogWwogICAgICAiUiIsCiAgICAgICJMUiIsCiAgICAgICJ1UiIsCiAgICAgICJVUiIsCiAgICAgICJ1OFIiCiAgICBdCiAgfSwKICAiQysrIEhlYWRlciI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaGgiLAogICAgICAiaHBwIiwKICAgICAgImh4eCIsCiAgICAgICJpbmwiLAogICAgICAiaXBwIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdLAogICAgInJhd19zdHJpbmdfcHJlZml4ZXMiOiBbCiAgICAgICJSIiwKICAgICAgIkxSIiwKICAgICAgInVSIiwKICAgICAgIlVSIiwKICAgICAgInU4UiIKICAgIF0KICB9LAogICJDTWFrZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY21ha2UiCiAgICBdLAogICAgImZpbGVuYW1lcyI6IFsKICAgICAgImNtYWtlbGlzdHMudHh0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdCiAgICBdCiAgfSwKICAiQ09CT0wiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNvYiIsCiAgICAgICJjYmwiLAogICAgICAiY2NwIiwKICAgICAgImNvYm9sIiwKICAgICAgImNweSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiKiIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiQ1NTIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjc3MiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJDU1YiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjc3YiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkNhYmFsIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjYWJhbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiey0iLAogICAgICAgICItfSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkNhcmdvIExvY2siOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNhcmdvLmxvY2siCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiXFxcIlxcXCJcXFwiIiwKICAgICAgICAiXFxcIlxcXCJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIicnJyIsCiAgICAgICAgIicnJyIKICAgICAgXQogICAgXQogIH0sCiAgIkNhc3NpdXMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNhc3NpdXMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJDZXlsb24iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNleWxvbiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkNsb2p1cmUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNsaiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiQ2xvanVyZVNjcmlwdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY2xqcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiQ2xvc3VyZSBUZW1wbGF0ZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIiwKICAgICAgIj49ICIsCiAgICAgICI8PSAiLAogICAgICAiPzogIiwKICAgICAgIj8gOiAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzb3kiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qKiIsCiAgICAgICAgIiovIgogICAgICBdLAogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJDb2ZmZWVTY3JpcHQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNvZmZlZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIjIyMiLAogICAgICAgICIjIyMiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdCiAgICBdCiAgfSwKICAiQ29nZW50IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjb2dlbnQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi0tIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJDb2xkRnVzaW9uIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjZm0iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLS0iLAogICAgICAgICItLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICInIiwKICAgICAgICAiJyIKICAgICAgXQogICAgXQogIH0sCiAgIkNvbGRGdXNpb24gQ0ZTY3JpcHQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNmYyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkNvcSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIoKiIsCiAgICAgICAgIiopIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiQ3Jlb2xlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY3Jlb2xlIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJDcnlzdGFsIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjciIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICInIiwKICAgICAgICAiJyIKICAgICAgXQogICAgXQogIH0sCiAgIkN5dGhvbiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAiYW5kICIsCiAgICAgICJvciAiLAogICAgICAibm90ICIsCiAgICAgICJpbiAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJweXgiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiXFxcIlxcXCJcXFwiIiwKICAgICAgICAiXFxcIlxcXCJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIicnJyIsCiAgICAgICAgIicnJyIKICAgICAgXQogICAgXQogIH0sCiAgIkQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiLysiLAogICAgICAgICIrLyIKICAgICAgXQogICAgXSwKICAgICJuZXN0ZWRtdWx0aWxpbmUiOiB0cnVlLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdCiAgICBdCiAgfSwKICAiRGFydCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZGFydCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkRldmljZSBUcmVlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJkdHMiLAogICAgICAiZHRzaSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkRoYWxsIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZGhhbGwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi0tIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgInstIiwKICAgICAgICAiLX0iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJEb2NrZXJmaWxlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJkb2NrZXJpZ25vcmUiCiAgICBdLAogICAgImZpbGVuYW1lcyI6IFsKICAgICAgImRvY2tlcmZpbGUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0KICAgIF0KICB9LAogICJEb2N1bWVudCBUeXBlIERlZmluaXRpb24iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJkdGQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkVsaXhpciI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZXgiLAogICAgICAiZXhzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCJcXFwiXFxcIiIsCiAgICAgICAgIlxcXCJcXFwiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICInJyciLAogICAgICAgICInJyciCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0KICAgIF0KICB9LAogICJFbG0iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImVsbSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiey0iLAogICAgICAgICItfSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkVtYWNzIERldiBFbnYiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImVkZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiRW1hY3MgTGlzcCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIjsiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkVybGFuZyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZXJsIiwKICAgICAgImhybCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiJSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiRXhwZWN0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJleHAiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0KICAgIF0KICB9LAogICJFeHRlbnNpYmxlIFN0eWxlc2hlZXQgTGFuZ3VhZ2UgVHJhbnNmb3JtYXRpb25zIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAieHNsdCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiRiMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImZzIiwKICAgICAgImZzaSIsCiAgICAgICJmc3giLAogICAgICAiZnNzY3JpcHQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIigqIiwKICAgICAgICAiKikiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJGKiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZnN0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIigqIiwKICAgICAgICAiKikiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJGSURMIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJmaWRsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiRk9SVFJBTiBMZWdhY3kiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImYiLAogICAgICAiZm9yIiwKICAgICAgImZ0biIsCiAgICAgICJmNzciLAogICAgICAicGZvIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICJjIiwKICAgICAgIkMiLAogICAgICAiISIsCiAgICAgICIqIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdCiAgICBdCiAgfSwKICAiRk9SVFJBTiBNb2Rlcm4iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImYwMyIsCiAgICAgICJmMDgiLAogICAgICAiZjkwIiwKICAgICAgImY5NSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiISIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkZpc2giOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImZpc2giCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0KICAgIF0sCiAgICAic2hlYmFuZ3MiOiBbCiAgICAgICJmaXNoIgogICAgXQogIH0sCiAgIkZvcnRoIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICI0dGgiLAogICAgICAiZm9ydGgiLAogICAgICAiZnIiLAogICAgICAiZnJ0IiwKICAgICAgImZ0aCIsCiAgICAgICJmODMiLAogICAgICAiZmIiLAogICAgICAiZnBtIiwKICAgICAgImU0IiwKICAgICAgInJ4IiwKICAgICAgImZ0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICJcXFxcIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIiggIiwKICAgICAgICAiKSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkZyYWdtZW50IFNoYWRlciBGaWxlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJmc2giCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJGcmVlbWFya2VyIFRlbXBsYXRlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICI8I2xpc3QgIiwKICAgICAgIjwjYXNzaWduICIsCiAgICAgICI8I2lmICIsCiAgICAgICI8I2Vsc2VpZiAiLAogICAgICAiPCNlbHNlPiIsCiAgICAgICI8I2Vsc2U+ICIsCiAgICAgICI8I3N3aXRjaCAgIiwKICAgICAgIjwjY2FzZSAiLAogICAgICAiPCNkZWZhdWx0PiIsCiAgICAgICI8I2RlZmF1bHQ+ICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZnRsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIjwjLS0iLAogICAgICAgICItLT4iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJHRFNjcmlwdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZ2QiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiXFxcIlxcXCJcXFwiIiwKICAgICAgICAiXFxcIlxcXCJcXFwiIgogICAgICBdCiAgICBdCiAgfSwKICAiR0xTTCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidmVydCIsCiAgICAgICJ0ZXNjIiwKICAgICAgInRlc2UiLAogICAgICAiZ2VvbSIsCiAgICAgICJmcmFnIiwKICAgICAgImNvbXAiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJHTiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiaWYoIiwKICAgICAgImlmICgiLAogICAgICAiZWxzZSBpZigiLAogICAgICAiZWxzZSBpZiAoIiwKICAgICAgImVsc2UoIiwKICAgICAgImVsc2UgKCIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJnbiIsCiAgICAgICJnbmkiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkdhbWUgTWFrZXIgTGFuZ3VhZ2UiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICJvciAiLAogICAgICAiJiYgIiwKICAgICAgImFuZCAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImdtbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iLAogICAgICAiLy8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJHYW1lIE1ha2VyIFByb2plY3QiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ5eXAiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkdoZXJraW4gU3BlY2lmaWNhdGlvbiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZ2l2ZW4iLAogICAgICAid2hlbiIsCiAgICAgICJ0aGVuIiwKICAgICAgImFuZCIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImZlYXR1cmUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkdvIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJnbyIKICAgIF0sCiAgICAiaW1wb3J0X2Jsb2NrcyI6IFsKICAgICAgWwogICAgICAgICJpbXBvcnQgKCIsCiAgICAgICAgIikiCiAgICAgIF0KICAgIF0sCiAgICAiaW1wb3J0cyI6IFsKICAgICAgImltcG9ydCAiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiYCIsCiAgICAgICAgImAiCiAgICAgIF0KICAgIF0sCiAgICAidGVzdF9maWxlcyI6IFsKICAgICAgIipfdGVzdC5nbyIKICAgIF0KICB9LAogICJHbyBUZW1wbGF0ZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAie3tpZiAiLAogICAgICAie3sgaWYgIiwKICAgICAgInt7ZWxzZSIsCiAgICAgICJ7eyBlbHNlIiwKICAgICAgInt7cmFuZ2UgIiwKICAgICAgInt7IHJhbmdlICIsCiAgICAgICJ7e3dpdGgiLAogICAgICAie3sgd2l0aCIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRtcGwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAie3svKiIsCiAgICAgICAgIiovfX0iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJHcmFkbGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJncmFkbGUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdLAogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJHcm9vdnkiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImdyb292eSIsCiAgICAgICJncnQiLAogICAgICAiZ3RwbCIsCiAgICAgICJndnkiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJIRVgiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJoZXgiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkhUTUwiOiB7CiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImh0bWwiLAogICAgICAiaHRtIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIjwhLS0iLAogICAgICAgICItLT4iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJIYW1sZXQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImhhbWxldCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiSGFuZGxlYmFycyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaGJzIiwKICAgICAgImhhbmRsZWJhcnMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXSwKICAgICAgWwogICAgICAgICJ7eyEiLAogICAgICAgICJ9fSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0KICAgIF0KICB9LAogICJIYXBweSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInkiLAogICAgICAibHkiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkhhc2tlbGwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImhzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJ7LSIsCiAgICAgICAgIi19IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiSGF4ZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaHgiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJJREwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImlkbCIsCiAgICAgICJ3ZWJpZGwiLAogICAgICAid2lkbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIklkcmlzIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJpZHIiLAogICAgICAibGlkciIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiey0iLAogICAgICAgICItfSIKICAgICAgXQogICAgXSwKICAgICJuZXN0ZWRtdWx0aWxpbmUiOiB0cnVlLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICJcXFwiXFxcIlxcXCIiLAogICAgICAgICJcXFwiXFxcIlxcXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJJbnRlbCBIRVgiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJpaGV4IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJJc2FiZWxsZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidGh5IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJ7KiIsCiAgICAgICAgIip9IgogICAgICBdLAogICAgICBbCiAgICAgICAgIigqIiwKICAgICAgICAiKikiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAi4oC5IiwKICAgICAgICAi4oC6IgogICAgICBdLAogICAgICBbCiAgICAgICAgIlxcXFw8b3Blbj4iLAogICAgICAgICJcXFxcPGNsb3NlPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiJyciLAogICAgICAgICInJyIKICAgICAgXQogICAgXQogIH0sCiAgIkpBSSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiamFpIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgIm5lc3RlZG11bHRpbGluZSI6IHRydWUsCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJKU09OIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAianNvbiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiSlNPTkwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJqc29ubCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiSlNYIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJqc3giCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJKYWRlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJpZiAiLAogICAgICAiZWxzZSBpZiAiLAogICAgICAidW5sZXNzICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImphZGUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiSmF2YSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiamF2YSIKICAgIF0sCiAgICAiaW1wb3J0cyI6IFsKICAgICAgImltcG9ydCAiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0sCiAgICAidGVzdF9maWxlcyI6IFsKICAgICAgIipUZXN0LmphdmEiLAogICAgICAiKlRlc3RzLmphdmEiCiAgICBdCiAgfSwKICAiSmF2YVNjcmlwdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAianMiLAogICAgICAibWpzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdLAogICAgInNoZWJhbmdzIjogWwogICAgICAibm9kZSIsCiAgICAgICJub2RlanMiCiAgICBdLAogICAgInRlc3RfZmlsZXMiOiBbCiAgICAgICIqLnNwZWMuanMiLAogICAgICAiKi50ZXN0LmpzIgogICAgXQogIH0sCiAgIkphdmFTZXJ2ZXIgUGFnZXMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImpzcCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkplbmtpbnMgQnVpbGRmaWxlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogW10sCiAgICAiZmlsZW5hbWVzIjogWwogICAgICAiamVua2luc2ZpbGUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkppbmphIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJ7JSBmb3IgIiwKICAgICAgInslLSBmb3IgIiwKICAgICAgInslIGlmICIsCiAgICAgICJ7JS0gaWYgIiwKICAgICAgInslIGVsc2UgIiwKICAgICAgInslLSBlbHNlICIsCiAgICAgICJ7JSBlbGlmICIsCiAgICAgICJ7JSBtYWNybyAiLAogICAgICAieyUtIG1hY3JvICIsCiAgICAgICJ7JSBjYWxsICIsCiAgICAgICJ7JS0gY2FsbCAiLAogICAgICAieyUgZmlsdGVyICIsCiAgICAgICJ7JS0gZmlsdGVyICIsCiAgICAgICJ7JSBzZXQgIiwKICAgICAgInslIGluY2x1ZGUgIiwKICAgICAgInslIGZyb20gIiwKICAgICAgInslIGV4dGVuZHMgIiwKICAgICAgInslIHdpdGggIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiamluamEiLAogICAgICAiajIiLAogICAgICAiamluamEyIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgInsjIiwKICAgICAgICAiI30iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJKdWxpYSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiamwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiIz0iLAogICAgICAgICI9IyIKICAgICAgXQogICAgXSwKICAgICJuZXN0ZWRtdWx0aWxpbmUiOiB0cnVlLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICJcXFwiXFxcIlxcXCIiLAogICAgICAgICJcXFwiXFxcIlxcXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJKdWxpdXMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImp1bGl1cyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkp1cHl0ZXIiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJpcHluYiIsCiAgICAgICJqcHluYiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiSnVzdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAianVzdGZpbGUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJLb3JuIFNoZWxsIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJrc2giLAogICAgICAiLmtzaHJjIgogICAgXSwKICAgICJoZXJlZG9jcyI6IFsKICAgICAgIjw8IiwKICAgICAgIjw8LSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICInIiwKICAgICAgICAiJyIKICAgICAgXQogICAgXSwKICAgICJzaGViYW5ncyI6IFsKICAgICAgImtzaCIKICAgIF0KICB9LAogICJLb3RsaW4iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImt0IiwKICAgICAgImt0cyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJuZXN0ZWRtdWx0aWxpbmUiOiB0cnVlLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiTEQgU2NyaXB0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJsZHMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJMRVNTIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibGVzcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIkxFWCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkxPTENPREUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgIkFXU1VNIFRIWCAiLAogICAgICAiTyBOT0VTICIsCiAgICAgICJQTFogT1BFTiBGSUxFICIsCiAgICAgICJJTSBJTiBZUiAiLAogICAgICAiTyBSTFk/IiwKICAgICAgIk8gUkxZPyAiLAogICAgICAiV1RGPyIsCiAgICAgICJXVEY/ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImxvbCIsCiAgICAgICJsb2xzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICJCVFciCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiT0JUVyIsCiAgICAgICAgIlRMRFIiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJMYVRlWCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRleCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiJSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiTGVhbiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibGVhbiIsCiAgICAgICJobGVhbiIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLy0iLAogICAgICAgICItLyIKICAgICAgXQogICAgXSwKICAgICJuZXN0ZWRtdWx0aWxpbmUiOiB0cnVlLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiTGljZW5zZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFtdLAogICAgImZpbGVuYW1lcyI6IFsKICAgICAgImxpY2Vuc2UiLAogICAgICAibGljZW5jZSIsCiAgICAgICJjb3B5aW5nIiwKICAgICAgImNvcHlpbmczIiwKICAgICAgInVubGljZW5zZSIsCiAgICAgICJ1bmxpY2VuY2UiLAogICAgICAibGljZW5zZS1taXQiLAogICAgICAibGljZW5jZS1taXQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkxpc3AiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImxpc3AiLAogICAgICAibHNwIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICI7IgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIiN8IiwKICAgICAgICAifCMiCiAgICAgIF0KICAgIF0sCiAgICAibmVzdGVkbXVsdGlsaW5lIjogdHJ1ZSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIkx1YSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibHVhIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICItLSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICItLVtbIiwKICAgICAgICAiXV0iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdCiAgICBdLAogICAgInNoZWJhbmdzIjogWwogICAgICAibHVhIgogICAgXQogIH0sCiAgIkx1Y2l1cyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibHVjaXVzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiTVFMIEhlYWRlciI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibXFoIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiTVFMNCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibXE0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiTVFMNSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibXE1IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiTVNCdWlsZCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiY3Nwcm9qIiwKICAgICAgInZicHJvaiIsCiAgICAgICJmc3Byb2oiLAogICAgICAicHJvcHMiLAogICAgICAidGFyZ2V0cyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiTVVNUFMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm1wcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiOyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiTWFjcm9tZWRpYSBlWHRlbnNpYmxlIE1hcmt1cCBMYW5ndWFnZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm14bWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIk1hZGxhbmciOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm1hZCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJ7IyIsCiAgICAgICAgIiN9IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiTWFrZWZpbGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm1hayIsCiAgICAgICJtayIsCiAgICAgICJicCIKICAgIF0sCiAgICAiZmlsZW5hbWVzIjogWwogICAgICAibWFrZWZpbGUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIk1ha28iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgIiUgZm9yICIsCiAgICAgICIlIGlmICIsCiAgICAgICIlIGVsc2UgIiwKICAgICAgIiUgZWxpZiAiLAogICAgICAiPCUgaW5jbHVkZSAiLAogICAgICAiPCVkZWYgIiwKICAgICAgIjwlcGFnZSAiLAogICAgICAiPCVkZWYgIiwKICAgICAgIjwlYmxvY2sgIiwKICAgICAgIjwlbmFtZXNwYWNlICIsCiAgICAgICI8JWluaGVyaXQgIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibWFrbyIsCiAgICAgICJtYW8iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIjwlZG9jPiIsCiAgICAgICAgIjwvJWRvYz4iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJNYXJrZG93biI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm1kIiwKICAgICAgIm1hcmtkb3duIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJNZXNvbiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yZWFjaCAiLAogICAgICAiaWYgIiwKICAgICAgImVsaWYgIiwKICAgICAgInVubGVzcyAiLAogICAgICAiYW5kICIsCiAgICAgICJvciAiLAogICAgICAiZWxzZSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJtZXNvbi5idWlsZCIsCiAgICAgICJtZXNvbl9vcHRpb25zLnR4dCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdLAogICAgICBbCiAgICAgICAgIicnJyIsCiAgICAgICAgIicnJyIKICAgICAgXQogICAgXQogIH0sCiAgIk1vZHVsYTMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm0zIiwKICAgICAgIm1nIiwKICAgICAgImlnIiwKICAgICAgImkzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIigqIiwKICAgICAgICAiKikiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJNb2R1bGUtRGVmaW5pdGlvbiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZGVmIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICI7IgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJNdXN0YWNoZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibXVzdGFjaGUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAie3shIiwKICAgICAgICAifX0iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdCiAgICBdCiAgfSwKICAiTmltIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJuaW0iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiXFxcIlxcXCJcXFwiIiwKICAgICAgICAiXFxcIlxcXCJcXFwiIgogICAgICBdCiAgICBdCiAgfSwKICAiTml4IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJuaXgiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJPQ2FtbCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibWwiLAogICAgICAibWxpIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIigqIiwKICAgICAgICAiKikiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJPYmplY3RpdmUgQyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIk9iamVjdGl2ZSBDKysiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIm1tIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiT3BhbGFuZyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAib3BhIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiT3JnIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJvcmciCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMgIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJPeiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAib3oiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiUiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIlBIUCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicGhwIgogICAgXSwKICAgICJoZXJlZG9jcyI6IFsKICAgICAgIjw8PCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIsCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICInIiwKICAgICAgICAiJyIKICAgICAgXQogICAgXSwKICAgICJzaGViYW5ncyI6IFsKICAgICAgInBocCIKICAgIF0sCiAgICAidGVzdF9maWxlcyI6IFsKICAgICAgIipUZXN0LnBocCIKICAgIF0KICB9LAogICJQS0dCVUlMRCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInBrZ2J1aWxkIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJQU0wgQXNzZXJ0aW9uIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJwc2wiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJQYXNjYWwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInBhcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJ7IiwKICAgICAgICAifSIKICAgICAgXSwKICAgICAgWwogICAgICAgICIoKiIsCiAgICAgICAgIiopIgogICAgICBdLAogICAgICBbCiAgICAgICAgInsiLAogICAgICAgICIqKSIKICAgICAgXSwKICAgICAgWwogICAgICAgICIoKiIsCiAgICAgICAgIn0iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdCiAgICBdCiAgfSwKICAiUGF0Y2giOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJwYXRjaCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiUGVybCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicGwiLAogICAgICAicG0iCiAgICBdLAogICAgImhlcmVkb2NzIjogWwogICAgICAiPDwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPXBvZCIsCiAgICAgICAgIj1jdXQiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdCiAgICBdLAogICAgInNoZWJhbmdzIjogWwogICAgICAicGVybCIKICAgIF0KICB9LAogICJQbGFpbiBUZXh0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidGV4dCIsCiAgICAgICJ0eHQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlBvbGx5IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJwb2xseSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiUG93ZXJzaGVsbCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAid2hpbGUgIiwKICAgICAgIndoaWxlKCIsCiAgICAgICJ1bnRpbCAiLAogICAgICAidW50aWwoIiwKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJmb3JlYWNoICIsCiAgICAgICJmb3JlYWNoKCIsCiAgICAgICJpZiAiLAogICAgICAiZWxzZWlmICIsCiAgICAgICJlbHNlICIsCiAgICAgICJzd2l0Y2giLAogICAgICAic3dpdGNoKCIsCiAgICAgICItZ3QiLAogICAgICAiLWx0IiwKICAgICAgIi1lcSIsCiAgICAgICItbmUiLAogICAgICAiLWdlIiwKICAgICAgIi1sZSIsCiAgICAgICItaW4iLAogICAgICAiLW5vdGluIiwKICAgICAgIi1jb250YWlucyIsCiAgICAgICItbm90Y29udGFpbnMiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJwczEiLAogICAgICAicHNtMSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IyIsCiAgICAgICAgIiM+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiUHJvY2Vzc2luZyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicGRlIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiUHJvbG9nIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJwIiwKICAgICAgInBybyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiJSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiUHJvcGVydGllcyBGaWxlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicHJvcGVydGllcyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiUHJvdG9jb2wgQnVmZmVycyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicHJvdG8iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJQdXBwZXQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInBwIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIj1iZWdpbiIsCiAgICAgICAgIj1lbmQiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdCiAgICBdCiAgfSwKICAiUHVyZVNjcmlwdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicHVycyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiey0iLAogICAgICAgICItfSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlB5dGhvbiI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAiYW5kICIsCiAgICAgICJvciAiLAogICAgICAibm90ICIsCiAgICAgICJpbiAiCiAgICBdLAogICAgImRvY3N0cmluZ3MiOiBbCiAgICAgICJcIlwiXCIiLAogICAgICAiJycnIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicHkiCiAgICBdLAogICAgImltcG9ydHMiOiBbCiAgICAgICJpbXBvcnQgIiwKICAgICAgImZyb20gIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiXCJcIlwiIiwKICAgICAgICAiXCJcIlwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIicnJyIsCiAgICAgICAgIicnJyIKICAgICAgXQogICAgXSwKICAgICJzaGViYW5ncyI6IFsKICAgICAgInB5dGhvbiIsCiAgICAgICJweXRob24yIiwKICAgICAgInB5dGhvbjMiCiAgICBdLAogICAgInRlc3RfZmlsZXMiOiBbCiAgICAgICJ0ZXN0XyoucHkiLAogICAgICAiKl90ZXN0LnB5IgogICAgXSwKICAgICJ2ZXJiYXRpbV9lc2NhcGUiOiAiXFwiLAogICAgInZlcmJhdGltX3ByZWZpeGVzIjogWwogICAgICAiciIsCiAgICAgICJSIiwKICAgICAgInJiIiwKICAgICAgInJCIiwKICAgICAgIlJiIiwKICAgICAgIlJCIiwKICAgICAgImJyIiwKICAgICAgImJSIiwKICAgICAgIkJyIiwKICAgICAgIkJSIgogICAgXQogIH0sCiAgIlFDTCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicWNsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiUU1MIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJxbWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJSIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJyIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJSYWtlZmlsZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicmFrZSIsCiAgICAgICJyYWtlZmlsZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI9YmVnaW4iLAogICAgICAgICI9ZW5kIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICInIiwKICAgICAgICAiJyIKICAgICAgXQogICAgXQogIH0sCiAgIlJhem9yIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJjc2h0bWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXSwKICAgICAgWwogICAgICAgICJAKiIsCiAgICAgICAgIipAIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiUmVTdHJ1Y3R1cmVkVGV4dCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInJzdCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiUmVwb3J0IERlZmluaXRpb24gTGFuZ3VhZ2UiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJyZGwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIlJvYm90IEZyYW1ld29yayI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInJvYm90IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJSdWJ5IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJyYiIKICAgIF0sCiAgICAiZmlsZW5hbWVzIjogWwogICAgICAiZ2VtZmlsZSIsCiAgICAgICJyYWtlZmlsZSIKICAgIF0sCiAgICAiaGVyZWRvY3MiOiBbCiAgICAgICI8PH4iLAogICAgICAiPDwtIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIj1iZWdpbiIsCiAgICAgICAgIj1lbmQiCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdCiAgICBdLAogICAgInNoZWJhbmdzIjogWwogICAgICAicnVieSIKICAgIF0sCiAgICAidGVzdF9maWxlcyI6IFsKICAgICAgIipfc3BlYy5yYiIsCiAgICAgICIqX3Rlc3QucmIiCiAgICBdCiAgfSwKICAiUnVieSBIVE1MIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJyaHRtbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiUnVzdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAicnMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAibmVzdGVkbXVsdGlsaW5lIjogdHJ1ZSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXSwKICAgICJ2ZXJiYXRpbV9wcmVmaXhlcyI6IFsKICAgICAgInIiCiAgICBdCiAgfSwKICAiU0FTIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJkbyIsCiAgICAgICIlZG8iLAogICAgICAiaWYiLAogICAgICAiJWlmIiwKICAgICAgImVsc2UiLAogICAgICAiJWVsc2UiLAogICAgICAiY2FzZSIsCiAgICAgICJvciIsCiAgICAgICJhbmQiLAogICAgICAiXj0iLAogICAgICAiwqw9IiwKICAgICAgIn49IiwKICAgICAgIm5lIiwKICAgICAgImVxIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic2FzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIqIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0KICAgIF0KICB9LAogICJTS0lMTCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiaWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIjsiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIlNQRFgiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzcGR4IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJTUUwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInNxbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0KICAgIF0KICB9LAogICJTUmVjb2RlIFRlbXBsYXRlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzcnQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIjs7IgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJTVkciOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInN2ZyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiU2FzcyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic2FzcyIsCiAgICAgICJzY3NzIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiU2NhbGEiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInNjIiwKICAgICAgInNjYWxhIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiU2NoZW1lIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzY20iLAogICAgICAic3MiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIjsiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiI3wiLAogICAgICAgICJ8IyIKICAgICAgXQogICAgXSwKICAgICJuZXN0ZWRtdWx0aWxpbmUiOiB0cnVlLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiU2NvbnMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImNzaWciLAogICAgICAic2NvbnN0cnVjdCIsCiAgICAgICJzY29uc2NyaXB0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdLAogICAgICBbCiAgICAgICAgIlxcXCJcXFwiXFxcIiIsCiAgICAgICAgIlxcXCJcXFwiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICInJyciLAogICAgICAgICInJyciCiAgICAgIF0KICAgIF0KICB9LAogICJTaGVsbCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic2giLAogICAgICAiLnRjc2hyYyIKICAgIF0sCiAgICAiaGVyZWRvY3MiOiBbCiAgICAgICI8PCIsCiAgICAgICI8PC0iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0KICAgIF0sCiAgICAic2hlYmFuZ3MiOiBbCiAgICAgICJzaCIKICAgIF0KICB9LAogICJTbWFydHkgVGVtcGxhdGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIsCiAgICAgICJpbmNsdWRlICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInRwbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICJ7KiIsCiAgICAgICAgIip9IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiU29mdGJyaWRnZSBCYXNpYyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAiZWxzZWlmICIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzYmwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiciCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJTcGVjbWFuIGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi0tIiwKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIic+IiwKICAgICAgICAiPCciCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogW10KICB9LAogICJTcGljZSBOZXRsaXN0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJja3QiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIioiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlN0YW5kYXJkIE1MIChTTUwpIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzbWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiKCoiLAogICAgICAgICIqKSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIlN0YXRhIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3JlYWNoIiwKICAgICAgImZvcnZhbHVlcyIsCiAgICAgICJpZiIsCiAgICAgICJlbHNlIiwKICAgICAgIndoaWxlIiwKICAgICAgInN3aXRjaCIsCiAgICAgICJ8IiwKICAgICAgIiYiLAogICAgICAiIT0iLAogICAgICAiPT0iCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJkbyIsCiAgICAgICJhZG8iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIiwKICAgICAgIioiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICJgXCIiLAogICAgICAgICJcIiciCiAgICAgIF0KICAgIF0KICB9LAogICJTdHlsdXMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiaWYgIiwKICAgICAgInVubGVzcyAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic3R5bCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIlN3aWZ0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzd2lmdCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJuZXN0ZWRtdWx0aWxpbmUiOiB0cnVlLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiU3dpZyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgImkiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIlN5c3RlbVZlcmlsb2ciOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInwgIiwKICAgICAgIiYgIiwKICAgICAgIiE9ICIsCiAgICAgICIhPT0gIiwKICAgICAgIj09ICIsCiAgICAgICJmb3JlYWNoICIsCiAgICAgICJmb3JlYWNoKCIsCiAgICAgICJjYXNlICIsCiAgICAgICJjYXNlKCIsCiAgICAgICJjYXNleCAiLAogICAgICAiY2FzZXgoIiwKICAgICAgImNhc2V6ICIsCiAgICAgICJjYXNleigiLAogICAgICAiY2FzZXh6ICIsCiAgICAgICJjYXNleHooIiwKICAgICAgImZvcmsgIiwKICAgICAgIiA/ICIsCiAgICAgICJpbnNpZGUiLAogICAgICAid2l0aCIsCiAgICAgICJldmVudCAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJzdiIsCiAgICAgICJzdmgiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJTeXN0ZW1kIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiYXV0b21vdW50IiwKICAgICAgImRldmljZSIsCiAgICAgICJsaW5rIiwKICAgICAgIm1vdW50IiwKICAgICAgInBhdGgiLAogICAgICAic2NvcGUiLAogICAgICAic2VydmljZSIsCiAgICAgICJzbGljZSIsCiAgICAgICJzb2NrZXQiLAogICAgICAic3dhcCIsCiAgICAgICJ0YXJnZXQiLAogICAgICAidGltZXIiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlRDTCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidGNsIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdCiAgICBdLAogICAgInNoZWJhbmdzIjogWwogICAgICAidGNsc2giLAogICAgICAid2lzaCIKICAgIF0KICB9LAogICJUT01MIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ0b21sIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdLAogICAgICBbCiAgICAgICAgIlxcXCJcXFwiXFxcIiIsCiAgICAgICAgIlxcXCJcXFwiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICInJyciLAogICAgICAgICInJyciCiAgICAgIF0KICAgIF0KICB9LAogICJUYXNrUGFwZXIiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ0YXNrcGFwZXIiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlRlWCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidGV4IiwKICAgICAgInN0eSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiJSIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiVGhyaWZ0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidGhyaWZ0IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIsCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0KICAgIF0KICB9LAogICJUd2lnIFRlbXBsYXRlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJ7JSBmb3IgIiwKICAgICAgInslIGlmICIsCiAgICAgICJ7JSBlbHNlICIsCiAgICAgICJ7JSBlbHNlaWYgIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidHdpZyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiVHlwZVNjcmlwdCI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifCAiLAogICAgICAifHwgIiwKICAgICAgIiYgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiIT09ICIsCiAgICAgICI9PSAiLAogICAgICAiPT09ICIsCiAgICAgICJmb3JlYWNoKCIsCiAgICAgICJjYXNlICIsCiAgICAgICJjYXNlKCIsCiAgICAgICJjYXNleCAiLAogICAgICAiY2FzZXgoIiwKICAgICAgImNhc2V6ICIsCiAgICAgICJjYXNleigiLAogICAgICAiY2FzZXh6ICIsCiAgICAgICJjYXNleHooIiwKICAgICAgImZvcmsiLAogICAgICAiPyIsCiAgICAgICI6IiwKICAgICAgImluc2lkZSIsCiAgICAgICJ3aXRoIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidHMiLAogICAgICAidHN4IgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdLAogICAgInRlc3RfZmlsZXMiOiBbCiAgICAgICIqLnNwZWMudHMiLAogICAgICAiKi50ZXN0LnRzIgogICAgXQogIH0sCiAgIlR5cGVTY3JpcHQgVHlwaW5ncyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifCAiLAogICAgICAifHwgIiwKICAgICAgIiYgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiIT09ICIsCiAgICAgICI9PSAiLAogICAgICAiPT09ICIsCiAgICAgICJmb3JlYWNoKCIsCiAgICAgICJjYXNlICIsCiAgICAgICJjYXNlKCIsCiAgICAgICJjYXNleCAiLAogICAgICAiY2FzZXgoIiwKICAgICAgImNhc2V6ICIsCiAgICAgICJjYXNleigiLAogICAgICAiY2FzZXh6ICIsCiAgICAgICJjYXNleHooIiwKICAgICAgImZvcmsiLAogICAgICAiPyIsCiAgICAgICI6IiwKICAgICAgImluc2lkZSIsCiAgICAgICJ3aXRoIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAiZC50cyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIlVucmVhbCBTY3JpcHQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInVjIiwKICAgICAgInVjaSIsCiAgICAgICJ1cGtnIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiVXIvV2ViIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ1ciIsCiAgICAgICJ1cnMiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiKCoiLAogICAgICAgICIqKSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIlVyL1dlYiBQcm9qZWN0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ1cnAiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlZIREwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInZoZCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLS0iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlZhbGEiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInZhbGEiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJWYXJuaXNoIENvbmZpZ3VyYXRpb24iOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ2Y2wiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiLAogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlZlcmlsb2ciOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInZnIiwKICAgICAgInZoIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICIvKiIsCiAgICAgICAgIiovIgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiVmVyaWxvZyBBcmdzIEZpbGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJpcnVuYXJncyIsCiAgICAgICJ4cnVuYXJncyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiVmVydGV4IFNoYWRlciBGaWxlIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ2c2giCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIi8vIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIi8qIiwKICAgICAgICAiKi8iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJWaW0gU2NyaXB0IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ2aW0iCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIlxcXCIiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0sCiAgICAgIFsKICAgICAgICAiJyIsCiAgICAgICAgIiciCiAgICAgIF0KICAgIF0KICB9LAogICJWaXN1YWwgQmFzaWMiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgImVsc2VpZiAiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAidmIiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiciCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXFxcIiIsCiAgICAgICAgIlxcXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJWdWUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInZ1ZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfSwKICAiV29sZnJhbSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogWwogICAgICAiZm9yICIsCiAgICAgICJmb3IoIiwKICAgICAgImlmICIsCiAgICAgICJpZigiLAogICAgICAic3dpdGNoICIsCiAgICAgICJ3aGlsZSAiLAogICAgICAiZWxzZSAiLAogICAgICAifHwgIiwKICAgICAgIiYmICIsCiAgICAgICIhPSAiLAogICAgICAiPT0gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibmIiLAogICAgICAid2wiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiKCoiLAogICAgICAgICIqKSIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIlhBTUwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInhhbWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIlhDb2RlIENvbmZpZyI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInhjY29uZmlnIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIvLyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiWE1MIjogewogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ4bWwiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiPCEtLSIsCiAgICAgICAgIi0tPiIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIlhNTCBTY2hlbWEiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ4c2QiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFtdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIlh0ZW5kIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJmb3IgIiwKICAgICAgImZvcigiLAogICAgICAiaWYgIiwKICAgICAgImlmKCIsCiAgICAgICJzd2l0Y2ggIiwKICAgICAgIndoaWxlICIsCiAgICAgICJlbHNlICIsCiAgICAgICJ8fCAiLAogICAgICAiJiYgIiwKICAgICAgIiE9ICIsCiAgICAgICI9PSAiCiAgICBdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ4dGVuZCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbCiAgICAgIFsKICAgICAgICAiLyoiLAogICAgICAgICIqLyIKICAgICAgXQogICAgXSwKICAgICJxdW90ZXMiOiBbCiAgICAgIFsKICAgICAgICAiXCIiLAogICAgICAgICJcIiIKICAgICAgXQogICAgXQogIH0sCiAgIllBTUwiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFtdLAogICAgImV4dGVuc2lvbnMiOiBbCiAgICAgICJ5YW1sIiwKICAgICAgInltbCIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiIyIKICAgIF0sCiAgICAibXVsdGlfbGluZSI6IFtdLAogICAgInF1b3RlcyI6IFtdCiAgfSwKICAiWmlnIjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbCiAgICAgICJ3aGlsZSAiLAogICAgICAiZm9yICIsCiAgICAgICJpZiAiLAogICAgICAiZWxzZSAiLAogICAgICAiZGVmZXIgIiwKICAgICAgInN3aXRjaCIsCiAgICAgICJ8fCIsCiAgICAgICImJiIsCiAgICAgICIhPSIsCiAgICAgICI9PSIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInppZyIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogWwogICAgICAiLy8iCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcXFwiIiwKICAgICAgICAiXFxcIiIKICAgICAgXSwKICAgICAgWwogICAgICAgICJcXFxcIiwKICAgICAgICAiXG4iCiAgICAgIF0KICAgIF0KICB9LAogICJac2giOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInpzaCIsCiAgICAgICIuenNoZW52IiwKICAgICAgInpzaGVudiIsCiAgICAgICIuemxvZ2luIiwKICAgICAgInpsb2dpbiIsCiAgICAgICIuemxvZ291dCIsCiAgICAgICJ6bG9nb3V0IiwKICAgICAgIi56cHJvZmlsZSIsCiAgICAgICJ6cHJvZmlsZSIsCiAgICAgICIuenNocmMiLAogICAgICAienNocmMiCiAgICBdLAogICAgImhlcmVkb2NzIjogWwogICAgICAiPDwiLAogICAgICAiPDwtIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlxcXCIiLAogICAgICAgICJcXFwiIgogICAgICBdLAogICAgICBbCiAgICAgICAgIiciLAogICAgICAgICInIgogICAgICBdCiAgICBdLAogICAgInNoZWJhbmdzIjogWwogICAgICAienNoIgogICAgXQogIH0sCiAgImdpdGlnbm9yZSI6IHsKICAgICJjb21wbGV4aXR5Y2hlY2tzIjogW10sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgIi5naXRpZ25vcmUiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIm00IjogewogICAgImNvbXBsZXhpdHljaGVja3MiOiBbXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAibTQiCiAgICBdLAogICAgImxpbmVfY29tbWVudCI6IFsKICAgICAgIiMiCiAgICBdLAogICAgIm11bHRpX2xpbmUiOiBbXSwKICAgICJxdW90ZXMiOiBbXQogIH0sCiAgIm51c3BlYyI6IHsKICAgICJleHRlbnNpb25zIjogWwogICAgICAibnVzcGVjIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbXSwKICAgICJtdWx0aV9saW5lIjogWwogICAgICBbCiAgICAgICAgIjwhLS0iLAogICAgICAgICItLT4iCiAgICAgIF0KICAgIF0sCiAgICAicXVvdGVzIjogWwogICAgICBbCiAgICAgICAgIlwiIiwKICAgICAgICAiXCIiCiAgICAgIF0KICAgIF0KICB9LAogICJzZWQiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgImFuZCAiLAogICAgICAib3IgIiwKICAgICAgIm5vdCAiLAogICAgICAiaW4gIgogICAgXSwKICAgICJleHRlbnNpb25zIjogWwogICAgICAic2VkIgogICAgXSwKICAgICJsaW5lX2NvbW1lbnQiOiBbCiAgICAgICIjIgogICAgXSwKICAgICJtdWx0aV9saW5lIjogW10sCiAgICAicXVvdGVzIjogW10KICB9LAogICJTdmVsdGUiOiB7CiAgICAiY29tcGxleGl0eWNoZWNrcyI6IFsKICAgICAgImZvciAiLAogICAgICAiZm9yKCIsCiAgICAgICJpZiAiLAogICAgICAiaWYoIiwKICAgICAgInN3aXRjaCAiLAogICAgICAid2hpbGUgIiwKICAgICAgImVsc2UgIiwKICAgICAgInx8ICIsCiAgICAgICImJiAiLAogICAgICAiIT0gIiwKICAgICAgIj09ICIKICAgIF0sCiAgICAiZXh0ZW5zaW9ucyI6IFsKICAgICAgInN2ZWx0ZSIKICAgIF0sCiAgICAibGluZV9jb21tZW50IjogW10sCiAgICAibXVsdGlfbGluZSI6IFsKICAgICAgWwogICAgICAgICI8IS0tIiwKICAgICAgICAiLS0+IgogICAgICBdCiAgICBdLAogICAgInF1b3RlcyI6IFsKICAgICAgWwogICAgICAgICJcIiIsCiAgICAgICAgIlwiIgogICAgICBdCiAgICBdCiAgfQp9`
 )
@@ -0,0 +1,68 @@
+package processor
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// Names of the states of the counting state machine as written by --debug-file
+var stateNames = map[int64]string{
+	S_BLANK:              "blank",
+	S_CODE:               "code",
+	S_COMMENT:            "comment",
+	S_COMMENT_CODE:       "comment after code",
+	S_MULTICOMMENT:       "multiline comment",
+	S_MULTICOMMENT_CODE:  "multiline comment after code",
+	S_MULTICOMMENT_BLANK: "multiline comment ended",
+	S_STRING:             "string",
+	S_HEREDOC:            "heredoc",
+	S_VERBATIM:           "verbatim string",
+	S_DOCSTRING:          "docstring",
+	S_HEREDOC_INDENTED:   "indented heredoc",
+}
+
+// Set while counting the file passed to --debug-file so that every state change
+// and complexity match is written to traceOutput. Checked in the counting loop
+// so it is a bool rather than a comparison against DebugFile
+var traceStates = false
+var traceOutput io.Writer = os.Stderr
+
+// Writes a step of the state machine along with where in the file it happened
+func traceState(fileJob *FileJob, index int, msg string) {
+	fmt.Fprintf(traceOutput, "line %d offset %d: %s\n", fileJob.Lines+1, index, msg)
+}
+
+// Counts the single file writing each state the counter moves through to output, which shows
+// why a file is counted the way it is when troubleshooting a language definition
+func debugFile(path string, output io.Writer) error {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	name := filepath.Base(path)
+	language, extension, ok := detectLanguage(name, getExtensionLookup())
+	if !ok {
+		return fmt.Errorf("unable to determine the language of %s", path)
+	}
+
+	fileJob, ok := newContentFileJob(path, name, extension, language, content)
+	if !ok {
+		return fmt.Errorf("unable to determine the language of %s", path)
+	}
+
+	fmt.Fprintf(output, "%s %s\n", fileJob.Location, fileJob.Language)
+
+	traceStates, traceOutput = true, output
+	defer func() {
+		traceStates, traceOutput = false, os.Stderr
+	}()
+
+	CountStats(fileJob)
+
+	fmt.Fprintf(output, "lines %d code %d comment %d blank %d complexity %d\n", fileJob.Lines, fileJob.Code, fileJob.Comment, fileJob.Blank, fileJob.Complexity)
+	return nil
+}
@@ -0,0 +1,46 @@
+package processor
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDebugFile(t *testing.T) {
+	ProcessConstants()
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "main.go")
+	ioutil.WriteFile(path, []byte("// comment\nif x { s := \"a\" }\n"), 0600)
+
+	var output bytes.Buffer
+	if err := debugFile(path, &output); err != nil {
+		t.Fatalf("Expected no error got %s", err)
+	}
+
+	for _, line := range []string{
+		"line 1 offset 0: blank -> comment",
+		"line 1 offset 10: end of line in state comment",
+		"line 2 offset 11: matched complexity \"if \"",
+		"line 2 offset 11: blank -> code",
+		"line 2 offset 23: code -> string",
+		"line 2 offset 25: string -> code",
+		"lines 2 code 1 comment 1 blank 0 complexity 1",
+	} {
+		if !strings.Contains(output.String(), line) {
+			t.Errorf("Expected %s in output got %s", line, output.String())
+		}
+	}
+
+	if traceStates {
+		t.Error("Expected tracing to be disabled after the file is counted")
+	}
+
+	if err := debugFile(filepath.Join(dir, "unknown.unknown"), &output); err == nil {
+		t.Error("Expected error for missing file")
+	}
+}
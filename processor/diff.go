@@ -0,0 +1,192 @@
+package processor
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+)
+
+var tabularShortFormatDiff = "%-20s %+9d %+9d %+8d %+9d %+8d %+10d\n"
+
+// How a language changed between the two reports being compared
+const (
+	DiffAdded     = "added"
+	DiffRemoved   = "removed"
+	DiffChanged   = "changed"
+	DiffUnchanged = "unchanged"
+)
+
+// The change in the counts of a language between two reports
+type languageDiff struct {
+	Name       string
+	Status     string
+	Files      int64
+	Lines      int64
+	Code       int64
+	Comment    int64
+	Blank      int64
+	Complexity int64
+}
+
+// Top level object written out for a diff when the output format is JSON
+type diffSummary struct {
+	Languages []languageDiff
+	Total     languageDiff
+}
+
+// Loads the languages from a report previously written using the JSON format which
+// is either the current object with a total or the list of languages older versions wrote
+func loadReport(name string) ([]LanguageSummary, error) {
+	content, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	var summary jsonSummary
+	if err := json.Unmarshal(content, &summary); err == nil {
+		return summary.Languages, nil
+	}
+
+	var languages []LanguageSummary
+	if err := json.Unmarshal(content, &languages); err != nil {
+		return nil, fmt.Errorf("unable to read JSON report %s: %s", name, err)
+	}
+
+	return languages, nil
+}
+
+// Works out the change for every language in either report sorted by name
+func diffLanguages(oldLanguages []LanguageSummary, newLanguages []LanguageSummary) []languageDiff {
+	previous := map[string]LanguageSummary{}
+	for _, summary := range oldLanguages {
+		previous[summary.Name] = summary
+	}
+
+	current := map[string]LanguageSummary{}
+	for _, summary := range newLanguages {
+		current[summary.Name] = summary
+	}
+
+	diffs := []languageDiff{}
+	for _, summary := range newLanguages {
+		before, ok := previous[summary.Name]
+
+		diff := languageDiff{
+			Name:       summary.Name,
+			Files:      summary.Count - before.Count,
+			Lines:      summary.Lines - before.Lines,
+			Code:       summary.Code - before.Code,
+			Comment:    summary.Comment - before.Comment,
+			Blank:      summary.Blank - before.Blank,
+			Complexity: summary.Complexity - before.Complexity,
+		}
+
+		switch {
+		case !ok:
+			diff.Status = DiffAdded
+		case diff.Files == 0 && diff.Lines == 0 && diff.Code == 0 && diff.Comment == 0 && diff.Blank == 0 && diff.Complexity == 0:
+			diff.Status = DiffUnchanged
+		default:
+			diff.Status = DiffChanged
+		}
+
+		diffs = append(diffs, diff)
+	}
+
+	for _, summary := range oldLanguages {
+		if _, ok := current[summary.Name]; !ok {
+			diffs = append(diffs, languageDiff{
+				Name:       summary.Name,
+				Status:     DiffRemoved,
+				Files:      -summary.Count,
+				Lines:      -summary.Lines,
+				Code:       -summary.Code,
+				Comment:    -summary.Comment,
+				Blank:      -summary.Blank,
+				Complexity: -summary.Complexity,
+			})
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Name < diffs[j].Name
+	})
+
+	return diffs
+}
+
+func totalDiff(diffs []languageDiff) languageDiff {
+	total := languageDiff{Name: "Total", Status: DiffUnchanged}
+
+	for _, diff := range diffs {
+		total.Files += diff.Files
+		total.Lines += diff.Lines
+		total.Code += diff.Code
+		total.Comment += diff.Comment
+		total.Blank += diff.Blank
+		total.Complexity += diff.Complexity
+
+		if diff.Status != DiffUnchanged {
+			total.Status = DiffChanged
+		}
+	}
+
+	return total
+}
+
+// Compares the two JSON reports returning the change in the format requested
+func diffSummarize(oldReport string, newReport string) (string, error) {
+	oldLanguages, err := loadReport(oldReport)
+	if err != nil {
+		return "", err
+	}
+
+	newLanguages, err := loadReport(newReport)
+	if err != nil {
+		return "", err
+	}
+
+	diffs := diffLanguages(oldLanguages, newLanguages)
+
+	if strings.ToLower(Format) == "json" {
+		jsonString, _ := json.Marshal(diffSummary{
+			Languages: diffs,
+			Total:     totalDiff(diffs),
+		})
+		return string(jsonString), nil
+	}
+
+	return toDiffTabular(diffs), nil
+}
+
+func toDiffTabular(diffs []languageDiff) string {
+	var str strings.Builder
+
+	str.WriteString(tabularShortBreak)
+	str.WriteString(fmt.Sprintf(tabularShortFormatHead, "Language", "Files", "Lines", "Code", "Comments", "Blanks", "Complexity"))
+	str.WriteString(tabularShortBreak)
+
+	for _, diff := range diffs {
+		// Languages only in one of the reports are marked so they stand out from those which changed
+		suffix := ""
+		if diff.Status == DiffAdded || diff.Status == DiffRemoved {
+			suffix = " (" + diff.Status + ")"
+		}
+
+		trimmedName := diff.Name
+		if len(diff.Name)+len(suffix) > shortNameTruncate {
+			trimmedName = diff.Name[:shortNameTruncate-len(suffix)-1] + "…"
+		}
+
+		str.WriteString(fmt.Sprintf(tabularShortFormatDiff, trimmedName+suffix, diff.Files, diff.Lines, diff.Code, diff.Comment, diff.Blank, diff.Complexity))
+	}
+
+	total := totalDiff(diffs)
+	str.WriteString(tabularShortBreak)
+	str.WriteString(fmt.Sprintf(tabularShortFormatDiff, "Total", total.Files, total.Lines, total.Code, total.Comment, total.Blank, total.Complexity))
+	str.WriteString(tabularShortBreak)
+
+	return str.String()
+}
@@ -0,0 +1,87 @@
+package processor
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDiffLanguages(t *testing.T) {
+	oldLanguages := []LanguageSummary{
+		{Name: "Go", Count: 2, Lines: 100, Code: 80, Comment: 10, Blank: 10, Complexity: 20},
+		{Name: "Ruby", Count: 1, Lines: 10, Code: 10, Complexity: 2},
+		{Name: "Java", Count: 1, Lines: 5, Code: 5},
+	}
+	newLanguages := []LanguageSummary{
+		{Name: "Go", Count: 3, Lines: 90, Code: 70, Comment: 12, Blank: 8, Complexity: 15},
+		{Name: "Java", Count: 1, Lines: 5, Code: 5},
+		{Name: "Python", Count: 1, Lines: 4, Code: 3, Blank: 1, Complexity: 1},
+	}
+
+	diffs := diffLanguages(oldLanguages, newLanguages)
+	if len(diffs) != 4 {
+		t.Fatalf("Expected 4 languages got %d", len(diffs))
+	}
+
+	expected := []languageDiff{
+		{Name: "Go", Status: DiffChanged, Files: 1, Lines: -10, Code: -10, Comment: 2, Blank: -2, Complexity: -5},
+		{Name: "Java", Status: DiffUnchanged},
+		{Name: "Python", Status: DiffAdded, Files: 1, Lines: 4, Code: 3, Blank: 1, Complexity: 1},
+		{Name: "Ruby", Status: DiffRemoved, Files: -1, Lines: -10, Code: -10, Complexity: -2},
+	}
+
+	for i := range expected {
+		if diffs[i] != expected[i] {
+			t.Errorf("Expected %+v got %+v", expected[i], diffs[i])
+		}
+	}
+
+	total := totalDiff(diffs)
+	if total.Status != DiffChanged || total.Files != 1 || total.Code != -17 || total.Complexity != -6 {
+		t.Errorf("Unexpected total %+v", total)
+	}
+}
+
+func TestDiffSummarize(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	// Older versions wrote a list of languages rather than an object
+	oldReport := filepath.Join(dir, "old.json")
+	ioutil.WriteFile(oldReport, []byte(`[{"Name":"Go","Count":1,"Code":10},{"Name":"Ruby","Count":1,"Code":5}]`), 0600)
+
+	newReport := filepath.Join(dir, "new.json")
+	ioutil.WriteFile(newReport, []byte(`{"Languages":[{"Name":"Go","Count":1,"Code":12},{"Name":"Python","Count":1,"Code":3}],"Total":{}}`), 0600)
+
+	result, err := diffSummarize(oldReport, newReport)
+	if err != nil {
+		t.Fatalf("Expected no error got %s", err)
+	}
+
+	for _, line := range []string{"Go ", "Python (added)", "Ruby (removed)", "+2", "-5", "Total"} {
+		if !strings.Contains(result, line) {
+			t.Errorf("Expected %s in output got %s", line, result)
+		}
+	}
+
+	Format = "json"
+	defer func() { Format = "" }()
+
+	result, _ = diffSummarize(oldReport, newReport)
+
+	var res diffSummary
+	if err := json.Unmarshal([]byte(result), &res); err != nil {
+		t.Fatalf("Expected valid JSON got %s", err)
+	}
+
+	if len(res.Languages) != 3 || res.Total.Code != 0 || res.Total.Files != 0 {
+		t.Errorf("Unexpected diff %+v", res)
+	}
+
+	if _, err := diffSummarize(filepath.Join(dir, "missing.json"), newReport); err == nil {
+		t.Error("Expected error for missing report")
+	}
+}
@@ -0,0 +1,110 @@
+package processor
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var tabularShortFormatHeadDirectory = "%-25s %6s %9s %8s %8s %7s %10s\n"
+var tabularShortFormatDirectory = "%-25s %6d %9d %8d %8d %7d %10d\n"
+var tabularShortFormatHeadDirectoryNoComplexity = "%-36s %6s %9s %8s %8s %7s\n"
+var tabularShortFormatDirectoryNoComplexity = "%-36s %6d %9d %8d %8d %7d\n"
+var tabularWideFormatHeadDirectory = "%-55s %6s %9s %8s %8s %7s %10s\n"
+var tabularWideFormatDirectory = "%-55s %6d %9d %8d %8d %7d %10d\n"
+
+// The sum of the files under a directory, which for --by-directory is the
+// directory of each file cut down to the first ByDirectory parts of its path
+type directorySummary struct {
+	Name       string
+	Files      int64
+	Lines      int64
+	Code       int64
+	Comment    int64
+	Blank      int64
+	Complexity int64
+}
+
+// Returns the first depth directories of the path to the file relative to the path
+// being walked, or . for files directly in it
+func directoryName(location string, depth int) string {
+	parts := strings.Split(relativeLocation(location), "/")
+	parts = parts[:len(parts)-1]
+
+	if len(parts) > depth {
+		parts = parts[:depth]
+	}
+
+	if len(parts) == 0 {
+		return "."
+	}
+
+	return strings.Join(parts, "/")
+}
+
+// Sums the files of every language by directory with the directory with
+// the most code first, which is the largest module or service
+func aggregateDirectories(language []LanguageSummary, depth int) []directorySummary {
+	directories := map[string]directorySummary{}
+
+	for _, summary := range language {
+		for _, res := range summary.Files {
+			name := directoryName(res.Location, depth)
+			tmp := directories[name]
+
+			// The sections of a file are all in its directory so only one of them is a file
+			files := tmp.Files
+			if !res.extraSection {
+				files++
+			}
+
+			directories[name] = directorySummary{
+				Name:       name,
+				Files:      files,
+				Lines:      tmp.Lines + res.Lines,
+				Code:       tmp.Code + res.Code,
+				Comment:    tmp.Comment + res.Comment,
+				Blank:      tmp.Blank + res.Blank,
+				Complexity: tmp.Complexity + res.Complexity,
+			}
+		}
+	}
+
+	summaries := []directorySummary{}
+	for _, summary := range directories {
+		summaries = append(summaries, summary)
+	}
+
+	sort.Slice(summaries, func(i, j int) bool {
+		if summaries[i].Code == summaries[j].Code {
+			return summaries[i].Name < summaries[j].Name
+		}
+
+		return summaries[i].Code > summaries[j].Code
+	})
+
+	return summaries
+}
+
+// Writes the sum of the files under each directory for --by-directory with the
+// complexity column only when the format has one
+func directorySummarize(str *strings.Builder, tabularBreak string, headFormat string, format string, width int, complexity bool, language []LanguageSummary) {
+	if complexity {
+		str.WriteString(fmt.Sprintf(headFormat, "Directory", "Files", "Lines", "Code", "Comments", "Blanks", "Complexity"))
+	} else {
+		str.WriteString(fmt.Sprintf(headFormat, "Directory", "Files", "Lines", "Code", "Comments", "Blanks"))
+	}
+	str.WriteString(tabularBreak)
+
+	for _, summary := range aggregateDirectories(language, ByDirectory) {
+		name := truncateLocation(summary.Name, width)
+
+		if complexity {
+			str.WriteString(fmt.Sprintf(format, name, summary.Files, summary.Lines, summary.Code, summary.Comment, summary.Blank, summary.Complexity))
+		} else {
+			str.WriteString(fmt.Sprintf(format, name, summary.Files, summary.Lines, summary.Code, summary.Comment, summary.Blank))
+		}
+	}
+
+	str.WriteString(tabularBreak)
+}
@@ -0,0 +1,89 @@
+package processor
+
+import (
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestDirectoryName(t *testing.T) {
+	wd, _ := os.Getwd()
+	locationRoots = []string{wd}
+	defer func() { locationRoots = []string{} }()
+
+	tests := []struct {
+		location string
+		depth    int
+		expected string
+	}{
+		{"main.go", 1, "."},
+		{"processor/file.go", 1, "processor"},
+		{"./processor/file.go", 2, "processor"},
+		{"services/api/handlers/user.go", 1, "services"},
+		{"services/api/handlers/user.go", 2, "services/api"},
+		{"services/api/handlers/user.go", 5, "services/api/handlers"},
+	}
+
+	for _, test := range tests {
+		if got := directoryName(test.location, test.depth); got != test.expected {
+			t.Errorf("Expected %s for %s at depth %d got %s", test.expected, test.location, test.depth, got)
+		}
+	}
+}
+
+func TestAggregateDirectories(t *testing.T) {
+	language := []LanguageSummary{
+		{Name: "Go", Files: []*FileJob{
+			{Location: "api/main.go", Lines: 10, Code: 8, Blank: 2, Complexity: 3},
+			{Location: "api/handlers/user.go", Lines: 5, Code: 5, Complexity: 1},
+			{Location: "main.go", Lines: 2, Code: 2},
+		}},
+		{Name: "Python", Files: []*FileJob{
+			{Location: "web/app.py", Lines: 20, Code: 15, Comment: 5},
+		}},
+	}
+
+	expected := []directorySummary{
+		{Name: "web", Files: 1, Lines: 20, Code: 15, Comment: 5},
+		{Name: "api", Files: 2, Lines: 15, Code: 13, Blank: 2, Complexity: 4},
+		{Name: ".", Files: 1, Lines: 2, Code: 2},
+	}
+
+	got := aggregateDirectories(language, 1)
+	if len(got) != len(expected) {
+		t.Fatalf("Expected %d directories got %v", len(expected), got)
+	}
+
+	for i := range expected {
+		if got[i] != expected[i] {
+			t.Errorf("Expected %+v got %+v", expected[i], got[i])
+		}
+	}
+}
+
+func TestByDirectorySummarize(t *testing.T) {
+	ByDirectory = 1
+	defer func() { ByDirectory = 0 }()
+
+	newInput := func() chan *FileJob {
+		inputChan := make(chan *FileJob, 10)
+		inputChan <- &FileJob{Language: "Go", Location: "api/main.go", Lines: 10, Code: 8, Blank: 2}
+		close(inputChan)
+		return inputChan
+	}
+
+	if got := fileSummarizeShort(newInput()); !strings.Contains(got, "Directory") || !strings.Contains(got, "api ") {
+		t.Errorf("Expected directory in output got %s", got)
+	}
+
+	if got := fileSummarizeLong(newInput()); !strings.Contains(got, "Directory") || !strings.Contains(got, "api ") {
+		t.Errorf("Expected directory in output got %s", got)
+	}
+
+	var res jsonSummary
+	json.Unmarshal([]byte(toJson(newInput())), &res)
+	if len(res.Directories) != 1 || res.Directories[0].Name != "api" || res.Directories[0].Code != 8 {
+		t.Errorf("Expected api directory got %+v", res.Directories)
+	}
+}
@@ -1,17 +1,49 @@
 package processor
 
 import (
+	"archive/tar"
+	"archive/zip"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
 	"fmt"
 	"github.com/karrick/godirwalk"
 	"github.com/monochromegane/go-gitignore"
+	"io"
 	"io/ioutil"
+	"math"
+	"os"
+	"os/exec"
+	"os/user"
 	"path/filepath"
 	"regexp"
-	"runtime/debug"
+	"sort"
 	"strings"
 	"sync"
+	"time"
 )
 
+// Marker language for files which could not be identified by name
+// and need to be checked for a #! line when they are read
+const SheBang = "#!"
+
+// The pseudo language files which cannot be identified are counted under when CountUnknown is set.
+// Without any rules for comments or complexity they only have code and blank lines
+const UnknownLanguage = "Unknown"
+
+// Falls back to UnknownLanguage for a file which could not be identified when CountUnknown is set
+func orUnknown(language string, ok bool) (string, bool) {
+	if !ok && CountUnknown {
+		return UnknownLanguage, true
+	}
+
+	return language, ok
+}
+
+// How much of a file to read when checking for a #! line
+const sheBangReadLength = 256
+
 // Used as quick lookup for files with the same name to avoid some processing
 // needs to be sync.Map as it potentially could be called by many GoRoutines
 var extensionCache sync.Map
@@ -42,48 +74,885 @@ func getExtension(name string) string {
 	return extension.(string)
 }
 
-// Iterate over the supplied directory in parallel and each file that is not
-// excluded by the .gitignore and we know the extension of add to the supplied
-// channel. This attempts to span out in parallel based on the number of directories
-// in the supplied directory. Tests using a single process showed no lack of performance
-// even when hitting older spinning platter disks for this way
-//func walkDirectoryParallel(root string, output *RingBuffer) {
-func walkDirectoryParallel(root string, output chan *FileJob) {
+// Files which contain rules for which files in their directory should be ignored
+// where .ignore is used by tools such as ripgrep and the silver searcher
+var ignoreFiles = []string{".gitignore", ".ignore"}
+
+// Loads the rules from any ignore files in the supplied directory
+func loadIgnoreFiles(dir string) []gitignore.IgnoreMatcher {
+	var ignores []gitignore.IgnoreMatcher
+
+	for _, name := range ignoreFiles {
+		ignore, err := gitignore.NewGitIgnore(filepath.Join(dir, name))
+		if err == nil {
+			ignores = append(ignores, ignore)
+		}
+	}
+
+	return ignores
+}
+
+// Loads the rules from the global gitignore which is set using git's core.excludesFile
+// or defaults to $XDG_CONFIG_HOME/git/ignore, applying them relative to the supplied root
+func loadGlobalGitIgnore(root string) (gitignore.IgnoreMatcher, bool) {
+	location := ""
+	if out, err := exec.Command("git", "config", "--get", "core.excludesFile").Output(); err == nil {
+		location = strings.TrimSpace(string(out))
+	}
+
+	home := ""
+	if current, err := user.Current(); err == nil {
+		home = current.HomeDir
+	}
+
+	if strings.HasPrefix(location, "~/") {
+		location = filepath.Join(home, location[2:])
+	}
+
+	if location == "" {
+		configHome := os.Getenv("XDG_CONFIG_HOME")
+		if configHome == "" {
+			configHome = filepath.Join(home, ".config")
+		}
+		location = filepath.Join(configHome, "git", "ignore")
+	}
+
+	ignore, err := gitignore.NewGitIgnore(location, root)
+	if err != nil {
+		return nil, false
+	}
+
+	if Debug {
+		printDebug(fmt.Sprintf("using global gitignore: %s", location))
+	}
+	return ignore, true
+}
+
+// Compiled versions of ExcludePath which are set up once by processFlags
+var excludePathRegexes []*regexp.Regexp
+
+// Compiles each of the supplied regular expressions returning an error
+// identifying the first one which is invalid
+func compileExcludePaths(patterns []string) ([]*regexp.Regexp, error) {
+	var regexes []*regexp.Regexp
+
+	for _, pattern := range patterns {
+		regex, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid exclude path regular expression %s: %v", pattern, err)
+		}
+		regexes = append(regexes, regex)
+	}
+
+	return regexes, nil
+}
+
+// Checks if the full path of a file or directory matches any of the exclude path regular expressions
+func isExcludedPath(path string) bool {
+	for _, regex := range excludePathRegexes {
+		if regex.MatchString(path) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Checks if any of the supplied ignore rules match the path
+func isIgnored(ignores []gitignore.IgnoreMatcher, path string, isDir bool) bool {
+	for _, ignore := range ignores {
+		if ignore.Match(path, isDir) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// The parsed CountAs for the run which is used along with ExtensionToLanguage rather than
+// being added to it so that one run cannot change the languages of the next
+var countAsLanguage = map[string]string{}
+
+// Returns the language of the extension which is the one set by --count-as if there is one
+func extensionLanguage(extension string) (string, bool) {
+	if language, ok := countAsLanguage[extension]; ok {
+		return language, true
+	}
+
+	language, ok := ExtensionToLanguage[extension]
+	return language, ok
+}
+
+// Returns the extension to language lookup that should be used when identifying files
+// which when a white list of extensions is supplied is cut down to only those extensions
+// to avoid extra checks
+func getExtensionLookup() map[string]string {
+	if len(WhiteListExtensions) == 0 {
+		if len(ForceLanguage) == 0 && len(countAsLanguage) == 0 {
+			return ExtensionToLanguage
+		}
+
+		extensionLookup := map[string]string{}
+		for extension, language := range ExtensionToLanguage {
+			extensionLookup[extension] = language
+		}
+		for extension, language := range countAsLanguage {
+			extensionLookup[extension] = language
+		}
+		for extension, language := range ForceLanguage {
+			extensionLookup[extension] = language
+		}
+
+		return extensionLookup
+	}
+
+	wlExtensionLookup := map[string]string{}
+	for _, white := range WhiteListExtensions {
+		language, ok := ForceLanguage[white]
+
+		if !ok {
+			language, ok = extensionLanguage(white)
+		}
+
+		if ok {
+			wlExtensionLookup[white] = language
+		}
+	}
+
+	return wlExtensionLookup
+}
+
+// Checks each extension is being forced to a known language returning the
+// extensions without any leading . and the language names as they are known
+func parseForceLanguage(force map[string]string) (map[string]string, error) {
+	languages := map[string]string{}
+	for name := range LanguageFeatures {
+		languages[strings.ToLower(name)] = name
+	}
+
+	parsed := map[string]string{}
+	for extension, language := range force {
+		name, ok := languages[strings.ToLower(strings.TrimSpace(language))]
+		if !ok {
+			return nil, fmt.Errorf("unknown language %s for extension %s", language, extension)
+		}
+
+		parsed[strings.ToLower(strings.TrimPrefix(strings.TrimSpace(extension), "."))] = name
+	}
+
+	return parsed, nil
+}
+
+// Returns true if the directory should not be walked because its name is in ExcludeDir, which
+// matches at any depth, or because it is under an entry of ExcludeDir which is a path
+func isExcludedDir(path string) bool {
+	name := filepath.Base(path)
+	for _, dir := range ExcludeDir {
+		if strings.ContainsAny(dir, "/\\") {
+			dir = filepath.Clean(dir)
+			if path == dir || strings.HasPrefix(path, dir+string(filepath.Separator)) {
+				return true
+			}
+		} else if name == dir {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Ways the location of each file can be written when set using PathStyle
+const (
+	PathRelative = "relative"
+	PathAbsolute = "absolute"
+)
+
+// The absolute paths of the directories being walked which relative locations are written against
+var locationRoots = []string{}
+
+// Resolves the paths being walked into absolute directories, using the directory
+// of any file so that it is written by name, with the longest first so that the
+// most specific root of a location is used when the paths overlap
+func absoluteRoots(paths []string) []string {
+	roots := []string{}
+	for _, path := range paths {
+		// The files matched by a glob pattern are relative to the directory it is walked from
+		if _, err := os.Stat(path); err != nil && isGlob(path) {
+			path, _ = splitGlob(path)
+		}
+
+		root, err := filepath.Abs(path)
+		if err != nil {
+			continue
+		}
+
+		if info, err := os.Stat(root); err == nil && !info.IsDir() {
+			root = filepath.Dir(root)
+		}
+
+		roots = append(roots, root)
+	}
+
+	sort.Slice(roots, func(i, j int) bool {
+		return len(roots[i]) > len(roots[j])
+	})
+
+	return roots
+}
+
+// Rewrites the location of a file as set by PathStyle. Relative locations always use
+// forward slashes so that reports are the same wherever the paths were checked out
+func formatLocation(location string) string {
+	if PathStyle == "" {
+		return location
+	}
+
+	absolute, err := filepath.Abs(location)
+	if err != nil {
+		return location
+	}
+
+	if PathStyle == PathAbsolute {
+		return absolute
+	}
+
+	return relativeLocation(location)
+}
+
+// Returns the location relative to the most specific path being walked using forward
+// slashes, or the location itself if it is not under any of them
+func relativeLocation(location string) string {
+	absolute, err := filepath.Abs(location)
+	if err != nil {
+		return filepath.ToSlash(location)
+	}
+
+	for _, root := range locationRoots {
+		relative, err := filepath.Rel(root, absolute)
+		if err == nil && relative != ".." && !strings.HasPrefix(relative, ".."+string(filepath.Separator)) {
+			return filepath.ToSlash(relative)
+		}
+	}
+
+	return filepath.ToSlash(location)
+}
+
+// Parses extension:language pairs such as ts:typescript into the extension and the
+// name of the language it should be counted as, checking the language exists
+func parseCountAs(values []string) (map[string]string, error) {
+	pairs := map[string]string{}
+	for _, value := range values {
+		parts := strings.SplitN(value, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			return nil, fmt.Errorf("invalid --count-as %s expected extension:language", value)
+		}
+		pairs[parts[0]] = parts[1]
+	}
+
+	return parseForceLanguage(pairs)
+}
+
+// Identifies the language of a file based on its name returning the language,
+// the extension that was used to identify it and if it was able to be identified
+func detectLanguage(name string, extensionLookup map[string]string) (string, string, bool) {
+	extension := ""
+	lowerName := strings.ToLower(name)
+
+	// Exact file names such as Makefile or CMakeLists.txt are checked first as they are
+	// more specific than any extension, but are skipped if only specific extensions are wanted
+	if len(WhiteListExtensions) == 0 {
+		if language, ok := FileNameToLanguage[lowerName]; ok {
+			return language, extension, ok
+		}
+	}
+
+	// Lookup in case the full name matches
+	language, ok := extensionLookup[lowerName]
+
+	// If no match check if we have a matching extension
+	if !ok {
+		extension = getExtension(name)
+		language, ok = extensionLookup[extension]
+	}
+
+	// Convert from d.ts to ts and check that in case of multiple extensions
+	if !ok {
+		language, ok = extensionLookup[getExtension(extension)]
+	}
+
+	// Files we cannot identify by name may still have a #! line identifying them
+	// so mark them to be checked when read unless only specific extensions are wanted
+	if !ok && len(WhiteListExtensions) == 0 {
+		return SheBang, extension, true
+	}
+
+	return language, extension, ok
+}
+
+// Identifies the language of a file from the interpreter in its #! line
+// such as #!/usr/bin/env python3 or #!/bin/sh -e
+func detectSheBang(content []byte) (string, bool) {
+	if !bytes.HasPrefix(content, []byte(SheBang)) {
+		return "", false
+	}
+
+	if index := bytes.IndexByte(content, '\n'); index != -1 {
+		content = content[:index]
+	}
+
+	fields := strings.Fields(string(content[len(SheBang):]))
+	if len(fields) == 0 {
+		return "", false
+	}
+
+	interpreter := filepath.Base(fields[0])
+
+	// When run through env the interpreter is the first argument that is not an option or variable
+	if interpreter == "env" {
+		interpreter = ""
+		for _, field := range fields[1:] {
+			if !strings.HasPrefix(field, "-") && !strings.Contains(field, "=") {
+				interpreter = filepath.Base(field)
+				break
+			}
+		}
+	}
+
+	language, ok := SheBangToLanguage[interpreter]
+
+	// Cater for versioned interpreters such as python3.6 or perl5.26
+	if !ok {
+		language, ok = SheBangToLanguage[strings.TrimRight(interpreter, "0123456789.")]
+	}
+
+	return language, ok
+}
+
+// Reads the start of the file in order to identify the language from its #! line
+// which avoids reading the whole of files that turn out to be unknown
+func detectSheBangFile(location string) (string, bool) {
+	file, err := os.Open(location)
+	if err != nil {
+		return "", false
+	}
+	defer file.Close()
+
+	content := make([]byte, sheBangReadLength)
+	count, _ := io.ReadFull(file, content)
+
+	return detectSheBang(content[:count])
+}
+
+// Tracks the directories visited while following symlinks by their resolved path so
+// that a symlink loop or a symlink to a directory already walked is only walked once
+type visitedDirs struct {
+	mutex sync.Mutex
+	dirs  map[string]bool
+}
+
+func newVisitedDirs() *visitedDirs {
+	return &visitedDirs{dirs: map[string]bool{}}
+}
+
+// Returns true if the resolved directory has not been visited before marking it as visited
+func (v *visitedDirs) visit(path string) bool {
+	resolved, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return false
+	}
+
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+
+	if v.dirs[resolved] {
+		return false
+	}
+	v.dirs[resolved] = true
+	return true
+}
+
+// Reads newline separated file paths from the supplied reader and adds each file
+// that exists and we know the extension of to the supplied channel. Used when the
+// list of files to process comes from another tool rather than walking a directory
+func walkFileList(ctx context.Context, input io.Reader, output chan *FileJob) {
 	startTime := makeTimestampMilli()
-	extensionLookup := ExtensionToLanguage
+	extensionLookup := getExtensionLookup()
+
+	scanner := bufio.NewScanner(input)
+	for ctx.Err() == nil && scanner.Scan() {
+		location := strings.TrimSpace(scanner.Text())
+		if location == "" {
+			continue
+		}
+
+		if job, ok := newFileListJob(location, extensionLookup); ok {
+			select {
+			case output <- job:
+			case <-ctx.Done():
+			}
+		}
+	}
+
+	if err := scanner.Err(); err != nil && Verbose {
+		printWarn(fmt.Sprintf("error reading file list: %s", err))
+	}
+
+	if Debug {
+		printDebug(fmt.Sprintf("milliseconds to read file list: %d", makeTimestampMilli()-startTime))
+	}
+	close(output)
+}
+
+// Creates the job for a file named in a list of files rather than found by walking
+// returning false if it is excluded, missing, a directory or an unknown language
+func newFileListJob(location string, extensionLookup map[string]string) (*FileJob, bool) {
+	if isExcludedPath(location) {
+		if Verbose {
+			printWarn(fmt.Sprintf("skipping file due to match exclude path: %s", location))
+		}
+		return nil, false
+	}
+
+	info, err := os.Stat(location)
+	if err != nil {
+		if Verbose {
+			printWarn(fmt.Sprintf("skipping file that does not exist: %s", location))
+		}
+		return nil, false
+	}
+
+	if info.IsDir() {
+		if Verbose {
+			printWarn(fmt.Sprintf("skipping directory in file list: %s", location))
+		}
+		return nil, false
+	}
+
+	language, extension, ok := detectLanguage(info.Name(), extensionLookup)
+	if !ok {
+		if Verbose {
+			printWarn(fmt.Sprintf("skipping file unknown extension: %s", info.Name()))
+		}
+		return nil, false
+	}
+
+	return &FileJob{Location: location, Filename: info.Name(), Extension: extension, Language: language}, true
+}
+
+// Returns true if the path is a tar archive, which may be gzip compressed, that
+// should have the files inside it counted rather than being counted itself
+func isTarArchive(path string) bool {
+	lower := strings.ToLower(path)
+	return strings.HasSuffix(lower, ".tar") || strings.HasSuffix(lower, ".tar.gz") || strings.HasSuffix(lower, ".tgz")
+}
+
+// Returns true if the path is a zip archive that should have the files inside it counted
+func isZipArchive(path string) bool {
+	return strings.HasSuffix(strings.ToLower(path), ".zip")
+}
+
+// Reads the files in the tar or zip archive passing each to add
+func walkArchive(ctx context.Context, path string, extensionLookup map[string]string, add func(*FileJob)) error {
+	if isZipArchive(path) {
+		return walkZip(ctx, path, extensionLookup, add)
+	}
+
+	return walkTar(ctx, path, extensionLookup, add)
+}
+
+// Works out the language of a file inside an archive applying the exclude rules to it.
+// Returns false if the file should not be counted
+func newArchiveFileJob(path string, entry string, regex *regexp.Regexp, extensionLookup map[string]string) (*FileJob, bool) {
+	location := filepath.Join(path, entry)
+	name := filepath.Base(entry)
+
+	if regex != nil && regex.Match([]byte(name)) {
+		if Verbose {
+			printWarn("skipping file due to match exclude: " + location)
+		}
+		return nil, false
+	}
+
+	if isExcludedPath(location) {
+		if Verbose {
+			printWarn(fmt.Sprintf("skipping file due to match exclude path: %s", location))
+		}
+		return nil, false
+	}
+
+	language, extension, ok := detectLanguage(name, extensionLookup)
+	if !ok {
+		if Verbose {
+			printWarn(fmt.Sprintf("skipping file unknown extension: %s", name))
+		}
+		return nil, false
+	}
+
+	return &FileJob{Location: location, Filename: name, Extension: extension, Language: language}, true
+}
+
+// Reads each regular file in the tar archive passing it to add with the content already
+// read as the archive can only be read in order. Directories, links and anything else are
+// skipped. The location of each file is the file within the archive joined to the archive
+func walkTar(ctx context.Context, path string, extensionLookup map[string]string, add func(*FileJob)) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var reader io.Reader = file
+	if lower := strings.ToLower(path); strings.HasSuffix(lower, ".gz") || strings.HasSuffix(lower, ".tgz") {
+		gz, err := gzip.NewReader(file)
+		if err != nil {
+			return err
+		}
+		defer gz.Close()
+		reader = gz
+	}
+
+	var regex *regexp.Regexp
+	if Exclude != "" {
+		regex = regexp.MustCompile(Exclude)
+	}
+
+	archive := tar.NewReader(reader)
+	for ctx.Err() == nil {
+		header, err := archive.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if header.Typeflag != tar.TypeReg && header.Typeflag != tar.TypeRegA {
+			continue
+		}
+
+		entry, ok := newArchiveFileJob(path, header.Name, regex, extensionLookup)
+		if !ok {
+			continue
+		}
+
+		content, err := readArchiveEntry(archive, header.Size)
+		if err == errOverMaxFileSize {
+			skipOverMaxFileSize(entry.Location)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if job, ok := newContentFileJob(entry.Location, entry.Filename, entry.Extension, entry.Language, content); ok {
+			job.ModTime = fileModTime(header.ModTime)
+			add(job)
+		}
+	}
+
+	return nil
+}
+
+// Reads each regular file in the zip archive passing it to add with the content already
+// read. Directories and links are skipped. The location of each file is the file within
+// the archive joined to the archive
+func walkZip(ctx context.Context, path string, extensionLookup map[string]string, add func(*FileJob)) error {
+	archive, err := zip.OpenReader(path)
+	if err != nil {
+		return err
+	}
+	defer archive.Close()
+
+	var regex *regexp.Regexp
+	if Exclude != "" {
+		regex = regexp.MustCompile(Exclude)
+	}
+
+	for _, file := range archive.File {
+		if ctx.Err() != nil {
+			break
+		}
+
+		if !file.Mode().IsRegular() {
+			continue
+		}
+
+		entry, ok := newArchiveFileJob(path, file.Name, regex, extensionLookup)
+		if !ok {
+			continue
+		}
+
+		// A size too large for an int64 is over any MaxFileSize
+		size := int64(file.UncompressedSize64)
+		if file.UncompressedSize64 > math.MaxInt64 {
+			size = math.MaxInt64
+		}
+
+		if isOverMaxFileSize(size) {
+			skipOverMaxFileSize(entry.Location)
+			continue
+		}
+
+		reader, err := file.Open()
+		if err != nil {
+			return err
+		}
+
+		content, err := readArchiveEntry(reader, size)
+		reader.Close()
+		if err == errOverMaxFileSize {
+			skipOverMaxFileSize(entry.Location)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if job, ok := newContentFileJob(entry.Location, entry.Filename, entry.Extension, entry.Language, content); ok {
+			job.ModTime = fileModTime(file.Modified)
+			add(job)
+		}
+	}
+
+	return nil
+}
+
+// Reads a file in an archive which the archive says is size bytes. Files over MaxFileSize are
+// not read at all and no more than MaxFileSize is read from the rest as the size recorded in
+// the archive may not be what it decompresses to, such as for a zip bomb
+func readArchiveEntry(reader io.Reader, size int64) ([]byte, error) {
+	if isOverMaxFileSize(size) {
+		return nil, errOverMaxFileSize
+	}
 
-	// If input has a supplied white list of extensions then loop through them
-	// and modify the lookup we use to cut down on extra checks
-	if len(WhiteListExtensions) != 0 {
-		wlExtensionLookup := map[string]string{}
+	if MaxFileSize <= 0 {
+		return ioutil.ReadAll(reader)
+	}
+
+	content, err := ioutil.ReadAll(io.LimitReader(reader, MaxFileSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if isOverMaxFileSize(int64(len(content))) {
+		return nil, errOverMaxFileSize
+	}
+
+	return content, nil
+}
 
-		for _, white := range WhiteListExtensions {
-			language, ok := extensionLookup[white]
+// Records a file as skipped for being over MaxFileSize
+func skipOverMaxFileSize(location string) {
+	if Verbose {
+		printWarn(fmt.Sprintf("skipping file over max file size: %s", location))
+	}
+	skipped.Add(location, SkipFileSize)
+}
+
+// Modification times are kept to the second in UTC so they are written as RFC3339
+// and are the same in reports generated in different timezones. A zero time, such
+// as from an archive which does not record it, is nil so that it is left out of JSON
+func fileModTime(modTime time.Time) *time.Time {
+	if modTime.IsZero() {
+		return nil
+	}
+
+	modTime = modTime.UTC().Truncate(time.Second)
+	return &modTime
+}
 
+// Formats the modification time of a file using the layout, which is empty
+// for files such as those read from git where it is not known
+func formatModTime(modTime *time.Time, layout string) string {
+	if modTime == nil {
+		return ""
+	}
+
+	return modTime.Format(layout)
+}
+
+// Creates the FileJob for a file which has already been read such as from an archive
+// checking the #! line if needed. Returns false if the language cannot be identified
+func newContentFileJob(location string, name string, extension string, language string, content []byte) (*FileJob, bool) {
+	if language == SheBang {
+		var ok bool
+		language, ok = orUnknown(detectSheBang(content))
+
+		if !ok {
+			if Verbose {
+				printWarn(fmt.Sprintf("skipping file unknown extension: %s", name))
+			}
+			skipped.Add(location, SkipUnknown)
+			return nil, false
+		}
+	}
+
+	if isOverMaxFileSize(int64(len(content))) {
+		skipOverMaxFileSize(location)
+		return nil, false
+	}
+
+	// Empty files still need content so they are not read from disk
+	if content == nil {
+		content = []byte{}
+	}
+
+	return &FileJob{Location: location, Filename: name, Extension: extension, Language: language, Content: decodeContent(content)}, true
+}
+
+// Walks each of the supplied paths feeding the results into the same output channel.
+// Directories are walked while files are added directly. Paths can overlap such as
+// src and src/lib so files are only ever added once based on their absolute location
+func walkPaths(ctx context.Context, paths []string, output chan *FileJob) {
+	extensionLookup := getExtensionLookup()
+	seen := map[string]bool{}
+	paths = expandGlobs(paths)
+
+	add := func(job *FileJob) {
+		location, err := filepath.Abs(job.Location)
+		if err != nil {
+			location = job.Location
+		}
+
+		if seen[location] {
+			if Verbose {
+				printWarn(fmt.Sprintf("skipping file already added: %s", job.Location))
+			}
+			return
+		}
+		seen[location] = true
+
+		select {
+		case output <- job:
+		case <-ctx.Done():
+		}
+	}
+
+	// Every directory is walked at the same time so that the latency of reading one is hidden
+	// behind the others, but they are drained in order so the first path a file is found
+	// under is always the one it is added from
+	infos := make([]os.FileInfo, len(paths))
+	queues := make([]chan *FileJob, len(paths))
+	for i, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			continue
+		}
+
+		infos[i] = info
+		if info.IsDir() {
+			queues[i] = make(chan *FileJob, FileListQueueSize)
+			go walkDirectoryParallel(ctx, path, queues[i])
+		}
+	}
+
+	for i, path := range paths {
+		if ctx.Err() != nil {
+			break
+		}
+
+		info := infos[i]
+		if info == nil {
+			if Verbose {
+				printWarn(fmt.Sprintf("skipping path that does not exist: %s", path))
+			}
+			continue
+		}
+
+		if !info.IsDir() && (isTarArchive(path) || isZipArchive(path)) {
+			if err := walkArchive(ctx, path, extensionLookup, add); err != nil {
+				if Verbose {
+					printWarn(fmt.Sprintf("error reading archive: %s %s", path, err))
+				}
+				skipped.Add(path, SkipReadError)
+				readErrors.Add(path, err.Error())
+			}
+			continue
+		}
+
+		if !info.IsDir() {
+			if isExcludedPath(path) {
+				if Verbose {
+					printWarn(fmt.Sprintf("skipping file due to match exclude path: %s", path))
+				}
+				continue
+			}
+
+			language, extension, ok := detectLanguage(info.Name(), extensionLookup)
 			if ok {
-				wlExtensionLookup[white] = language
+				add(&FileJob{Location: path, Filename: info.Name(), Extension: extension, Language: language})
+			} else if Verbose {
+				printWarn(fmt.Sprintf("skipping file unknown extension: %s", info.Name()))
 			}
+			continue
 		}
 
-		extensionLookup = wlExtensionLookup
+		for job := range queues[i] {
+			// Keep draining on cancellation so the walker is able to exit
+			if ctx.Err() == nil {
+				add(job)
+			}
+		}
 	}
 
-	var mutex = &sync.Mutex{}
-	totalCount := 0
+	close(output)
+}
+
+// Iterate over the supplied directory in parallel and each file that is not
+// excluded by the .gitignore and we know the extension of add to the supplied
+// channel. This attempts to span out in parallel based on the number of directories
+// in the supplied directory, with at most DirectoryWalkJobWorkers being walked at once.
+// Tests using a single process showed no lack of performance even when hitting older
+// spinning platter disks for this way
+//func walkDirectoryParallel(root string, output *RingBuffer) {
+func walkDirectoryParallel(ctx context.Context, root string, output chan *FileJob) {
+	startTime := makeTimestampMilli()
+	extensionLookup := getExtensionLookup()
 
 	var wg sync.WaitGroup
+	semaphore := make(chan struct{}, max(DirectoryWalkJobWorkers, 1))
 	all, _ := ioutil.ReadDir(root)
-	// TODO the gitignore should check for further gitignores deeper in the tree
-	gitignore, gitignoreerror := gitignore.NewGitIgnore(filepath.Join(root, ".gitignore"))
-	resetGc := false
-
+	ignores := loadIgnoreFiles(root)
+	if global, ok := loadGlobalGitIgnore(root); ok {
+		ignores = append(ignores, global)
+	}
 	var regex *regexp.Regexp
 
 	if Exclude != "" {
 		regex = regexp.MustCompile(Exclude)
 	}
 
+	var visited *visitedDirs
+	if FollowSymlinks {
+		visited = newVisitedDirs()
+		visited.visit(root)
+	}
+
 	for _, f := range all {
+		if ctx.Err() != nil {
+			break
+		}
+
+		// Symlinked files are always read through the link while symlinked
+		// directories are only walked when following symlinks
+		if f.Mode()&os.ModeSymlink != 0 {
+			target, err := os.Stat(filepath.Join(root, f.Name()))
+			if err != nil {
+				if Verbose {
+					printWarn(fmt.Sprintf("skipping broken symlink: %s", filepath.Join(root, f.Name())))
+				}
+				continue
+			}
+
+			if target.IsDir() && !FollowSymlinks {
+				if Verbose {
+					printWarn(fmt.Sprintf("skipping symlinked directory: %s", filepath.Join(root, f.Name())))
+				}
+				continue
+			}
+			f = target
+		}
+
 		// Godirwalk despite being faster than the default walk is still too slow to feed the
 		// CPU's and so we need to walk in parallel to keep up as much as possible
 		if f.IsDir() {
@@ -99,6 +968,13 @@ func walkDirectoryParallel(root string, output chan *FileJob) {
 				}
 			}
 
+			if !shouldSkip && isExcludedDir(filepath.Join(root, f.Name())) {
+				if Verbose {
+					printWarn(fmt.Sprintf("skipping directory due to match exclude dir: %s", filepath.Join(root, f.Name())))
+				}
+				shouldSkip = true
+			}
+
 			if Exclude != "" {
 				if regex.Match([]byte(f.Name())) {
 					if Verbose {
@@ -108,28 +984,48 @@ func walkDirectoryParallel(root string, output chan *FileJob) {
 				}
 			}
 
+			if !shouldSkip && isExcludedPath(filepath.Join(root, f.Name())) {
+				if Verbose {
+					printWarn("skipping directory due to match exclude path: " + filepath.Join(root, f.Name()))
+				}
+				shouldSkip = true
+			}
+
+			if !shouldSkip && isIgnored(ignores, filepath.Join(root, f.Name()), true) {
+				if Verbose {
+					printWarn("skipping directory due to ignore file: " + f.Name())
+				}
+				shouldSkip = true
+			}
+
+			if !shouldSkip && visited != nil && !visited.visit(filepath.Join(root, f.Name())) {
+				if Verbose {
+					printWarn("skipping directory already walked: " + filepath.Join(root, f.Name()))
+				}
+				shouldSkip = true
+			}
+
 			if !shouldSkip {
 				wg.Add(1)
 				go func(toWalk string) {
-					filejobs := walkDirectory(toWalk, PathBlacklist, extensionLookup)
-					for i := 0; i < len(filejobs); i++ {
-						output <- &filejobs[i]
-					}
+					defer wg.Done()
 
-					mutex.Lock()
-					totalCount += len(filejobs)
-					mutex.Unlock()
+					// Released before sending so a walk blocked on the output never holds a slot
+					semaphore <- struct{}{}
+					filejobs := walkDirectory(ctx, toWalk, PathBlacklist, extensionLookup, ignores, visited)
+					<-semaphore
 
-					// Turn GC back to what it was before if we have parsed enough files
-					if !resetGc && totalCount >= GcFileCount {
-						debug.SetGCPercent(gcPercent)
-						resetGc = true
+					for i := 0; i < len(filejobs); i++ {
+						select {
+						case output <- &filejobs[i]:
+						case <-ctx.Done():
+							return
+						}
 					}
-					wg.Done()
 				}(filepath.Join(root, f.Name()))
 			}
 		} else {
-			if gitignoreerror != nil || !gitignore.Match(filepath.Join(root, f.Name()), false) {
+			if !isIgnored(ignores, filepath.Join(root, f.Name()), false) {
 
 				shouldSkip := false
 				if Exclude != "" {
@@ -141,27 +1037,21 @@ func walkDirectoryParallel(root string, output chan *FileJob) {
 					}
 				}
 
-				if !shouldSkip {
-					extension := ""
-					// Lookup in case the full name matches
-					language, ok := extensionLookup[strings.ToLower(f.Name())]
-
-					// If no match check if we have a matching extension
-					if !ok {
-						extension = getExtension(f.Name())
-						language, ok = extensionLookup[extension]
+				if !shouldSkip && isExcludedPath(filepath.Join(root, f.Name())) {
+					if Verbose {
+						printWarn("skipping file due to match exclude path: " + filepath.Join(root, f.Name()))
 					}
+					shouldSkip = true
+				}
 
-					// Convert from d.ts to ts and check that in case of multiple extensions
-					if !ok {
-						language, ok = extensionLookup[getExtension(extension)]
-					}
+				if !shouldSkip {
+					language, extension, ok := detectLanguage(f.Name(), extensionLookup)
 
 					if ok {
-						output <- &FileJob{Location: filepath.Join(root, f.Name()), Filename: f.Name(), Extension: extension, Language: language}
-						mutex.Lock()
-						totalCount++
-						mutex.Unlock()
+						select {
+						case output <- &FileJob{Location: filepath.Join(root, f.Name()), Filename: f.Name(), Extension: extension, Language: language}:
+						case <-ctx.Done():
+						}
 					} else if Verbose {
 						printWarn(fmt.Sprintf("skipping file unknown extension: %s", f.Name()))
 					}
@@ -171,20 +1061,50 @@ func walkDirectoryParallel(root string, output chan *FileJob) {
 	}
 
 	wg.Wait()
-	close(output)
 	if Debug {
 		printDebug(fmt.Sprintf("milliseconds to walk directory: %d", makeTimestampMilli()-startTime))
 	}
+	close(output)
 }
 
-func walkDirectory(toWalk string, blackList []string, extensionLookup map[string]string) []FileJob {
-	extension := ""
+// Walks the directory returning the files which should be processed. When visited is not nil
+// symlinked directories are followed with visited used to avoid walking the same directory more than once
+func walkDirectory(ctx context.Context, toWalk string, blackList []string, extensionLookup map[string]string, ignores []gitignore.IgnoreMatcher, visited *visitedDirs) []FileJob {
 	var filejobs []FileJob
 
+	// The ignore rules which apply to the contents of each directory, being those of the directory
+	// itself and all of its parents. As the walk visits directories before their contents the
+	// rules for the parent are always available by the time they are needed
+	dirIgnores := map[string][]gitignore.IgnoreMatcher{}
+
 	godirwalk.Walk(toWalk, &godirwalk.Options{
 		// Unsorted is meant to make the walk faster and we need to sort after processing anyway
-		Unsorted: true,
+		Unsorted:            true,
+		FollowSymbolicLinks: visited != nil,
 		Callback: func(root string, info *godirwalk.Dirent) error {
+			// Returning the error halts the walk via the ErrorCallback
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+
+			isDir := info.IsDir()
+			if info.IsSymlink() {
+				target, err := os.Stat(root)
+				if err != nil {
+					if Verbose {
+						printWarn(fmt.Sprintf("skipping broken symlink: %s", root))
+					}
+					return nil
+				}
+
+				if target.IsDir() && visited == nil {
+					if Verbose {
+						printWarn(fmt.Sprintf("skipping symlinked directory: %s", root))
+					}
+					return nil
+				}
+				isDir = target.IsDir()
+			}
 
 			var regex *regexp.Regexp
 			if Exclude != "" {
@@ -194,7 +1114,7 @@ func walkDirectory(toWalk string, blackList []string, extensionLookup map[string
 			if Exclude != "" {
 				if regex.Match([]byte(info.Name())) {
 					if Verbose {
-						if info.IsDir() {
+						if isDir {
 							printWarn("skipping directory due to match exclude: " + root)
 						} else {
 							printWarn("skipping file due to match exclude: " + root)
@@ -204,7 +1124,7 @@ func walkDirectory(toWalk string, blackList []string, extensionLookup map[string
 				}
 			}
 
-			if info.IsDir() {
+			if isDir {
 				for _, black := range blackList {
 					if strings.HasPrefix(root, black+"/") || strings.HasPrefix(root, black) {
 						if Verbose {
@@ -213,23 +1133,57 @@ func walkDirectory(toWalk string, blackList []string, extensionLookup map[string
 						return filepath.SkipDir
 					}
 				}
+
+				if isExcludedDir(root) {
+					if Verbose {
+						printWarn(fmt.Sprintf("skipping directory due to match exclude dir: %s", root))
+					}
+					return filepath.SkipDir
+				}
 			}
 
-			if !info.IsDir() {
-				// Lookup in case the full name matches
-				language, ok := extensionLookup[strings.ToLower(info.Name())]
+			if isExcludedPath(root) {
+				if Verbose {
+					printWarn(fmt.Sprintf("skipping due to match exclude path: %s", root))
+				}
 
-				// If no match check if we have a matching extension
-				if !ok {
-					extension = getExtension(info.Name())
-					language, ok = extensionLookup[extension]
+				if isDir {
+					return filepath.SkipDir
 				}
+				return nil
+			}
+
+			parentIgnores, ok := dirIgnores[filepath.Dir(root)]
+			if !ok {
+				parentIgnores = ignores
+			}
 
-				// Convert from d.ts to ts and check that in case of multiple extensions
-				if !ok {
-					language, ok = extensionLookup[getExtension(extension)]
+			if isIgnored(parentIgnores, root, isDir) {
+				if Verbose {
+					printWarn(fmt.Sprintf("skipping due to ignore file: %s", root))
 				}
 
+				if isDir {
+					return filepath.SkipDir
+				}
+				return nil
+			}
+
+			if isDir {
+				// The directory being walked was marked as visited before the walk started
+				if visited != nil && root != toWalk && !visited.visit(root) {
+					if Verbose {
+						printWarn(fmt.Sprintf("skipping directory already walked: %s", root))
+					}
+					return filepath.SkipDir
+				}
+
+				dirIgnores[root] = append(append([]gitignore.IgnoreMatcher{}, parentIgnores...), loadIgnoreFiles(root)...)
+			}
+
+			if !isDir {
+				language, extension, ok := detectLanguage(info.Name(), extensionLookup)
+
 				if ok {
 					filejobs = append(filejobs, FileJob{Location: root, Filename: info.Name(), Extension: extension, Language: language})
 				} else if Verbose {
@@ -240,6 +1194,10 @@ func walkDirectory(toWalk string, blackList []string, extensionLookup map[string
 			return nil
 		},
 		ErrorCallback: func(osPathname string, err error) godirwalk.ErrorAction {
+			if ctx.Err() != nil {
+				return godirwalk.Halt
+			}
+
 			if Verbose {
 				printWarn(fmt.Sprintf("error walking: %s %s", osPathname, err))
 			}
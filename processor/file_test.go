@@ -1,7 +1,19 @@
 package processor
 
 import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io/ioutil"
 	"math/rand"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
 	"testing"
 )
 
@@ -69,6 +81,224 @@ func TestGetExtensionSecondPass(t *testing.T) {
 	}
 }
 
+func TestWalkFileList(t *testing.T) {
+	ProcessConstants()
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	ioutil.WriteFile(filepath.Join(dir, "main.go"), []byte("package main"), 0600)
+	ioutil.WriteFile(filepath.Join(dir, "unknown.unknownextension"), []byte("unknown"), 0600)
+
+	input := strings.Join([]string{
+		filepath.Join(dir, "main.go"),
+		filepath.Join(dir, "unknown.unknownextension"),
+		filepath.Join(dir, "missing.go"),
+		dir,
+		"",
+	}, "\n")
+
+	output := make(chan *FileJob, 10)
+	walkFileList(context.Background(), strings.NewReader(input), output)
+
+	var jobs []*FileJob
+	for job := range output {
+		jobs = append(jobs, job)
+	}
+
+	if len(jobs) != 2 {
+		t.Fatalf("Expected 2 files got %d", len(jobs))
+	}
+
+	if jobs[0].Language != "Go" || jobs[0].Filename != "main.go" {
+		t.Errorf("Expected Go main.go got %s %s", jobs[0].Language, jobs[0].Filename)
+	}
+
+	if jobs[1].Language != SheBang {
+		t.Errorf("Expected unknown file to be checked for #! got %s", jobs[1].Language)
+	}
+}
+
+func TestDetectLanguageFileName(t *testing.T) {
+	ProcessConstants()
+
+	cases := map[string]string{
+		"Dockerfile":     "Dockerfile",
+		"dockerfile":     "Dockerfile",
+		"Makefile":       "Makefile",
+		"CMakeLists.txt": "CMake",
+		"Rakefile":       "Ruby",
+		"main.go":        "Go",
+	}
+
+	for name, expected := range cases {
+		got, _, ok := detectLanguage(name, ExtensionToLanguage)
+
+		if !ok || got != expected {
+			t.Errorf("Expected %s for %s got %s", expected, name, got)
+		}
+	}
+}
+
+func TestWalkDirectoryDockerfile(t *testing.T) {
+	ProcessConstants()
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	os.Mkdir(filepath.Join(dir, "build"), 0700)
+	ioutil.WriteFile(filepath.Join(dir, "build", "Dockerfile"), []byte("FROM scratch\n"), 0600)
+
+	jobs := walkDirectory(context.Background(), dir, []string{}, ExtensionToLanguage, nil, nil)
+
+	if len(jobs) != 1 || jobs[0].Language != "Dockerfile" {
+		t.Errorf("Expected Dockerfile to be counted got %v", jobs)
+	}
+}
+
+func TestWalkDirectoryParallelIgnoreFiles(t *testing.T) {
+	ProcessConstants()
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		".gitignore":              "ignored.go\nvendor/\n",
+		"main.go":                 "package main",
+		"ignored.go":              "package main",
+		"vendor/lib.go":           "package lib",
+		"sub/.ignore":             "local.go\n",
+		"sub/kept.go":             "package sub",
+		"sub/ignored.go":          "package sub",
+		"sub/deeper/local.go":     "package deeper",
+		"sub/deeper/kept.go":      "package deeper",
+		"other/local.go":          "package other",
+		"other/nested/.gitignore": "*.go\n",
+		"other/nested/gone.go":    "package nested",
+	}
+
+	for name, content := range files {
+		os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0700)
+		ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600)
+	}
+
+	output := make(chan *FileJob, 100)
+	walkDirectoryParallel(context.Background(), dir, output)
+
+	var got []string
+	for job := range output {
+		if job.Language == "Go" {
+			rel, _ := filepath.Rel(dir, job.Location)
+			got = append(got, filepath.ToSlash(rel))
+		}
+	}
+	sort.Strings(got)
+
+	expected := []string{"main.go", "other/local.go", "sub/deeper/kept.go", "sub/kept.go"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v got %v", expected, got)
+	}
+}
+
+func TestLoadGlobalGitIgnore(t *testing.T) {
+	if out, _ := exec.Command("git", "config", "--get", "core.excludesFile").Output(); len(out) != 0 {
+		t.Skip("core.excludesFile is configured")
+	}
+
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	original := os.Getenv("XDG_CONFIG_HOME")
+	os.Setenv("XDG_CONFIG_HOME", dir)
+	defer os.Setenv("XDG_CONFIG_HOME", original)
+
+	os.MkdirAll(filepath.Join(dir, "git"), 0700)
+	ioutil.WriteFile(filepath.Join(dir, "git", "ignore"), []byte("*.secret\n"), 0600)
+
+	ignore, ok := loadGlobalGitIgnore("/project")
+	if !ok {
+		t.Fatal("Expected global gitignore to be loaded")
+	}
+
+	if !ignore.Match("/project/sub/password.secret", false) {
+		t.Error("Expected global rule to match")
+	}
+}
+
+func TestDetectSheBang(t *testing.T) {
+	ProcessConstants()
+
+	cases := map[string]string{
+		"#!/usr/bin/env python3\nprint('hello')": "Python",
+		"#!/usr/bin/python3.6 -u":                "Python",
+		"#!/usr/bin/perl -w":                     "Perl",
+		"#!/usr/bin/env ruby":                    "Ruby",
+		"#!/bin/bash\necho hello":                "BASH",
+		"#!/bin/sh -e":                           "Shell",
+		"#!/usr/bin/env -S node --harmony":       "JavaScript",
+		"#! /usr/bin/php":                        "PHP",
+	}
+
+	for content, expected := range cases {
+		got, ok := detectSheBang([]byte(content))
+
+		if !ok || got != expected {
+			t.Errorf("Expected %s for %q got %s", expected, content, got)
+		}
+	}
+}
+
+func TestDetectSheBangNoMatch(t *testing.T) {
+	ProcessConstants()
+
+	for _, content := range []string{"", "#!", "#!/usr/bin/env", "#!/usr/bin/unknowninterpreter", "print('#!/bin/sh')"} {
+		if got, ok := detectSheBang([]byte(content)); ok {
+			t.Errorf("Expected no match for %q got %s", content, got)
+		}
+	}
+}
+
+func TestDetectSheBangFile(t *testing.T) {
+	ProcessConstants()
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	location := filepath.Join(dir, "script")
+	ioutil.WriteFile(location, []byte("#!/usr/bin/env python\nprint('hello')\n"), 0600)
+
+	got, ok := detectSheBangFile(location)
+	if !ok || got != "Python" {
+		t.Errorf("Expected Python got %s", got)
+	}
+
+	if _, ok := detectSheBangFile(filepath.Join(dir, "missing")); ok {
+		t.Error("Expected no match for missing file")
+	}
+}
+
+func TestNewContentFileJobCountUnknown(t *testing.T) {
+	ProcessConstants()
+	defer func() { CountUnknown = false }()
+
+	content := []byte("KEY=value\n\nOTHER=value\n")
+	if _, ok := newContentFileJob("archive.tar/.env", ".env", "env", SheBang, content); ok {
+		t.Error("Expected unknown file to be skipped")
+	}
+
+	CountUnknown = true
+	job, ok := newContentFileJob("archive.tar/.env", ".env", "env", SheBang, content)
+	if !ok || job.Language != UnknownLanguage {
+		t.Fatalf("Expected %s got %v", UnknownLanguage, job)
+	}
+
+	CountStats(job)
+	if job.Lines != 3 || job.Code != 2 || job.Blank != 1 || job.Comment != 0 || job.Complexity != 0 {
+		t.Errorf("Expected 3 lines 2 code 1 blank got %d %d %d", job.Lines, job.Code, job.Blank)
+	}
+
+	job, _ = newContentFileJob("archive.tar/script", "script", "", SheBang, []byte("#!/bin/sh\n"))
+	if job.Language != "Shell" {
+		t.Errorf("Expected Shell got %s", job.Language)
+	}
+}
+
 func BenchmarkGetExtensionDifferent(b *testing.B) {
 	for i := 0; i < b.N; i++ {
 
@@ -97,3 +327,434 @@ func randStringBytes(n int) string {
 	}
 	return string(b)
 }
+
+func TestCompileExcludePathsInvalid(t *testing.T) {
+	if _, err := compileExcludePaths([]string{`.*\.pb\.go$`, `[`}); err == nil {
+		t.Error("Expected error for invalid regular expression")
+	}
+}
+
+func TestWalkDirectoryParallelExcludePath(t *testing.T) {
+	ProcessConstants()
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{"main.go", "main_test.go", "api/api.pb.go", "api/api.go", "generated/gen.go"} {
+		os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0700)
+		ioutil.WriteFile(filepath.Join(dir, name), []byte("package main"), 0600)
+	}
+
+	excludePathRegexes, _ = compileExcludePaths([]string{`.*\.pb\.go$`, `_test\.go$`, `generated$`})
+	defer func() { excludePathRegexes = nil }()
+
+	output := make(chan *FileJob, 100)
+	walkDirectoryParallel(context.Background(), dir, output)
+
+	var got []string
+	for job := range output {
+		rel, _ := filepath.Rel(dir, job.Location)
+		got = append(got, filepath.ToSlash(rel))
+	}
+	sort.Strings(got)
+
+	expected := []string{"api/api.go", "main.go"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v got %v", expected, got)
+	}
+}
+
+func TestWalkDirectoryParallelExcludeDir(t *testing.T) {
+	ProcessConstants()
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{"main.go", "node_modules/a.js", "web/node_modules/lib/b.js", "web/app.js", "web/node_modules_docs/c.js", "api/gen/api.go", "other/api/gen/d.go"} {
+		os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0700)
+		ioutil.WriteFile(filepath.Join(dir, name), []byte("main"), 0600)
+	}
+
+	ExcludeDir = []string{"node_modules", filepath.Join(dir, "api", "gen")}
+	defer func() { ExcludeDir = []string{} }()
+
+	output := make(chan *FileJob, 100)
+	walkDirectoryParallel(context.Background(), dir, output)
+
+	var got []string
+	for job := range output {
+		rel, _ := filepath.Rel(dir, job.Location)
+		got = append(got, filepath.ToSlash(rel))
+	}
+	sort.Strings(got)
+
+	expected := []string{"main.go", "other/api/gen/d.go", "web/app.js", "web/node_modules_docs/c.js"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v got %v", expected, got)
+	}
+}
+
+func TestWalkDirectoryParallelSingleWorker(t *testing.T) {
+	ProcessConstants()
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	expected := []string{}
+	for i := 0; i < 20; i++ {
+		name := fmt.Sprintf("dir%02d/sub/main.go", i)
+		os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0700)
+		ioutil.WriteFile(filepath.Join(dir, name), []byte("package main"), 0600)
+		expected = append(expected, name)
+	}
+
+	walkers := DirectoryWalkJobWorkers
+	DirectoryWalkJobWorkers = 1
+	defer func() { DirectoryWalkJobWorkers = walkers }()
+
+	// The output holding a single file means most walks are blocked sending while the rest wait
+	output := make(chan *FileJob, 1)
+	go walkDirectoryParallel(context.Background(), dir, output)
+
+	got := []string{}
+	for job := range output {
+		rel, _ := filepath.Rel(dir, job.Location)
+		got = append(got, filepath.ToSlash(rel))
+	}
+	sort.Strings(got)
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v got %v", expected, got)
+	}
+}
+
+func TestWalkPaths(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	files := map[string]string{
+		"src/main.go":    "package main",
+		"src/lib/lib.go": "package lib",
+		"other/other.go": "package other",
+		"single.go":      "package single",
+	}
+
+	for name, content := range files {
+		os.MkdirAll(filepath.Dir(filepath.Join(dir, name)), 0700)
+		ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0600)
+	}
+
+	paths := []string{
+		filepath.Join(dir, "src"),
+		filepath.Join(dir, "src", "lib"),
+		filepath.Join(dir, "other"),
+		filepath.Join(dir, "single.go"),
+		filepath.Join(dir, "src", "main.go"),
+		filepath.Join(dir, "missing"),
+	}
+
+	output := make(chan *FileJob, 100)
+	walkPaths(context.Background(), paths, output)
+
+	var got []string
+	for job := range output {
+		rel, _ := filepath.Rel(dir, job.Location)
+		got = append(got, filepath.ToSlash(rel))
+	}
+	sort.Strings(got)
+
+	expected := []string{"other/other.go", "single.go", "src/lib/lib.go", "src/main.go"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v got %v", expected, got)
+	}
+}
+
+func TestWalkDirectoryParallelFollowSymlinks(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	other, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(other)
+
+	os.MkdirAll(filepath.Join(dir, "src"), 0700)
+	ioutil.WriteFile(filepath.Join(dir, "src", "main.go"), []byte("package main"), 0600)
+	os.MkdirAll(filepath.Join(other, "docs"), 0700)
+	ioutil.WriteFile(filepath.Join(other, "docs", "docs.go"), []byte("package docs"), 0600)
+
+	if err := os.Symlink(filepath.Join(other, "docs"), filepath.Join(dir, "docs")); err != nil {
+		t.Skip("symlinks are not supported")
+	}
+	os.Symlink(filepath.Join(dir, "src", "main.go"), filepath.Join(dir, "link.go"))
+	os.Symlink(filepath.Join(dir, "src", "main.go"), filepath.Join(dir, "src", "link.go"))
+	// Loops back to the root and to itself which would never finish if followed naively
+	os.Symlink(dir, filepath.Join(dir, "src", "loop"))
+	os.Symlink(filepath.Join(other, "docs"), filepath.Join(other, "docs", "self"))
+
+	walk := func() []string {
+		output := make(chan *FileJob, 100)
+		walkDirectoryParallel(context.Background(), dir, output)
+
+		var got []string
+		for job := range output {
+			rel, _ := filepath.Rel(dir, job.Location)
+			got = append(got, filepath.ToSlash(rel))
+		}
+		sort.Strings(got)
+		return got
+	}
+
+	// Symlinked files are counted either way with only symlinked directories needing the flag
+	expected := []string{"link.go", "src/link.go", "src/main.go"}
+	if got := walk(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v got %v", expected, got)
+	}
+
+	FollowSymlinks = true
+	defer func() { FollowSymlinks = false }()
+
+	expected = []string{"docs/docs.go", "link.go", "src/link.go", "src/main.go"}
+	if got := walk(); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v got %v", expected, got)
+	}
+}
+
+func TestParseForceLanguage(t *testing.T) {
+	ProcessConstants()
+
+	force, err := parseForceLanguage(map[string]string{".INC": "php", "tpl": "HTML"})
+	if err != nil {
+		t.Fatalf("Expected no error got %s", err)
+	}
+
+	expected := map[string]string{"inc": "PHP", "tpl": "HTML"}
+	if !reflect.DeepEqual(force, expected) {
+		t.Errorf("Expected %v got %v", expected, force)
+	}
+
+	if _, err := parseForceLanguage(map[string]string{"inc": "NotALanguage"}); err == nil {
+		t.Error("Expected error for unknown language")
+	}
+}
+
+func TestFormatLocation(t *testing.T) {
+	defer func() {
+		PathStyle = ""
+		locationRoots = []string{}
+	}()
+
+	wd, _ := os.Getwd()
+	location := filepath.Join("..", "processor", "file.go")
+
+	PathStyle = ""
+	if got := formatLocation(location); got != location {
+		t.Errorf("Expected %s got %s", location, got)
+	}
+
+	PathStyle = PathAbsolute
+	if got := formatLocation(location); got != filepath.Join(wd, "file.go") {
+		t.Errorf("Expected %s got %s", filepath.Join(wd, "file.go"), got)
+	}
+
+	PathStyle = PathRelative
+	locationRoots = absoluteRoots([]string{"..", wd})
+	if got := formatLocation(location); got != "file.go" {
+		t.Errorf("Expected file.go got %s", got)
+	}
+
+	locationRoots = absoluteRoots([]string{".."})
+	if got := formatLocation(location); got != "processor/file.go" {
+		t.Errorf("Expected processor/file.go got %s", got)
+	}
+
+	locationRoots = absoluteRoots([]string{"file.go"})
+	if got := formatLocation(location); got != "file.go" {
+		t.Errorf("Expected file.go got %s", got)
+	}
+}
+
+func TestParseCountAs(t *testing.T) {
+	ProcessConstants()
+
+	countAs, err := parseCountAs([]string{"ts:typescript", ".ES6:JavaScript"})
+	if err != nil {
+		t.Fatalf("Expected no error got %s", err)
+	}
+
+	expected := map[string]string{"ts": "TypeScript", "es6": "JavaScript"}
+	if !reflect.DeepEqual(countAs, expected) {
+		t.Errorf("Expected %v got %v", expected, countAs)
+	}
+
+	for _, value := range []string{"ts", ":typescript", "ts:NotALanguage"} {
+		if _, err := parseCountAs([]string{value}); err == nil {
+			t.Errorf("Expected error for %s", value)
+		}
+	}
+}
+
+func TestGetExtensionLookupForceLanguage(t *testing.T) {
+	ProcessConstants()
+	ForceLanguage = map[string]string{"inc": "PHP", "go": "Python"}
+	defer func() { ForceLanguage = map[string]string{} }()
+
+	language, _, _ := detectLanguage("config.inc", getExtensionLookup())
+	if language != "PHP" {
+		t.Errorf("Expected PHP got %s", language)
+	}
+
+	language, _, _ = detectLanguage("main.go", getExtensionLookup())
+	if language != "Python" {
+		t.Errorf("Expected Python got %s", language)
+	}
+
+	if ExtensionToLanguage["go"] != "Go" {
+		t.Errorf("Expected ExtensionToLanguage to be unchanged got %s", ExtensionToLanguage["go"])
+	}
+
+	WhiteListExtensions = []string{"inc"}
+	defer func() { WhiteListExtensions = []string{} }()
+
+	lookup := getExtensionLookup()
+	if len(lookup) != 1 || lookup["inc"] != "PHP" {
+		t.Errorf("Expected only inc as PHP got %v", lookup)
+	}
+}
+
+func TestWalkPathsTar(t *testing.T) {
+	ProcessConstants()
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	archive := filepath.Join(dir, "release.tar.gz")
+	file, _ := os.Create(archive)
+	gz := gzip.NewWriter(file)
+	tw := tar.NewWriter(gz)
+
+	entries := []struct {
+		header  tar.Header
+		content string
+	}{
+		{tar.Header{Name: "release/", Typeflag: tar.TypeDir, Mode: 0700}, ""},
+		{tar.Header{Name: "release/main.go", Typeflag: tar.TypeReg, Mode: 0600, Size: 12}, "package main"},
+		{tar.Header{Name: "release/link.go", Typeflag: tar.TypeSymlink, Linkname: "main.go"}, ""},
+		{tar.Header{Name: "release/run", Typeflag: tar.TypeReg, Mode: 0700, Size: 21}, "#!/usr/bin/env python"},
+	}
+
+	for _, entry := range entries {
+		tw.WriteHeader(&entry.header)
+		tw.Write([]byte(entry.content))
+	}
+	tw.Close()
+	gz.Close()
+	file.Close()
+
+	output := make(chan *FileJob, 10)
+	walkPaths(context.Background(), []string{archive}, output)
+
+	got := map[string]string{}
+	for job := range output {
+		rel, _ := filepath.Rel(archive, job.Location)
+		got[filepath.ToSlash(rel)] = job.Language
+
+		if job.Content == nil {
+			t.Errorf("Expected content to be read from the archive for %s", rel)
+		}
+	}
+
+	expected := map[string]string{"release/main.go": "Go", "release/run": "Python"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v got %v", expected, got)
+	}
+}
+
+func TestWalkPathsZip(t *testing.T) {
+	ProcessConstants()
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	archive := filepath.Join(dir, "release.zip")
+	file, _ := os.Create(archive)
+	zw := zip.NewWriter(file)
+
+	zw.Create("release/")
+	writer, _ := zw.Create("release/main.go")
+	writer.Write([]byte("package main"))
+	writer, _ = zw.Create("release/run")
+	writer.Write([]byte("#!/usr/bin/env python"))
+	writer, _ = zw.Create("release/unknown.unknown")
+	writer.Write([]byte("unknown"))
+	zw.Close()
+	file.Close()
+	defer skipped.Reset()
+
+	output := make(chan *FileJob, 10)
+	walkPaths(context.Background(), []string{archive}, output)
+
+	got := map[string]string{}
+	for job := range output {
+		rel, _ := filepath.Rel(archive, job.Location)
+		got[filepath.ToSlash(rel)] = job.Language
+
+		if job.Content == nil {
+			t.Errorf("Expected content to be read from the archive for %s", rel)
+		}
+	}
+
+	expected := map[string]string{"release/main.go": "Go", "release/run": "Python"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v got %v", expected, got)
+	}
+}
+
+func TestWalkPathsZipMaxFileSize(t *testing.T) {
+	ProcessConstants()
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	archive := filepath.Join(dir, "release.zip")
+	file, _ := os.Create(archive)
+	zw := zip.NewWriter(file)
+
+	writer, _ := zw.Create("release/main.go")
+	writer.Write([]byte("package main"))
+	// Compresses to far less than it decompresses to
+	writer, _ = zw.Create("release/large.go")
+	writer.Write([]byte(strings.Repeat("// padding\n", 10000)))
+	zw.Close()
+	file.Close()
+
+	MaxFileSize = 1024
+	defer func() { MaxFileSize = 0 }()
+	defer skipped.Reset()
+
+	output := make(chan *FileJob, 10)
+	walkPaths(context.Background(), []string{archive}, output)
+
+	got := []string{}
+	for job := range output {
+		got = append(got, job.Filename)
+	}
+
+	if !reflect.DeepEqual(got, []string{"main.go"}) {
+		t.Errorf("Expected only main.go got %v", got)
+	}
+
+	if files := skipped.Files(); len(files) != 1 || files[0].Reason != SkipFileSize {
+		t.Errorf("Expected large.go to be skipped for its size got %v", files)
+	}
+}
+
+func TestReadArchiveEntry(t *testing.T) {
+	MaxFileSize = 10
+	defer func() { MaxFileSize = 0 }()
+
+	if content, err := readArchiveEntry(strings.NewReader("package a"), 9); err != nil || string(content) != "package a" {
+		t.Errorf("Expected the content got %s %v", content, err)
+	}
+
+	if _, err := readArchiveEntry(strings.NewReader("package main"), 12); err != errOverMaxFileSize {
+		t.Errorf("Expected the declared size to be over the max got %v", err)
+	}
+
+	// The declared size cannot be trusted so no more than the max is read
+	if _, err := readArchiveEntry(strings.NewReader("package main"), 1); err != errOverMaxFileSize {
+		t.Errorf("Expected the content to be over the max got %v", err)
+	}
+}
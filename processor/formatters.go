@@ -5,117 +5,515 @@ import (
 	"encoding/csv"
 	"encoding/json"
 	"fmt"
-	glang "golang.org/x/text/language"
-	gmessage "golang.org/x/text/message"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"sort"
+	"strconv"
 	"strings"
+	"text/template"
 	"time"
+	"unicode/utf8"
 )
 
 var tabularShortBreak = "───────────────────────────────────────────────────────────────────────────────\n"
 var tabularShortFormatHead = "%-20s %9s %9s %8s %9s %8s %10s\n"
 var tabularShortFormatBody = "%-20s %9d %9d %8d %9d %8d %10d\n"
 var tabularShortFormatFile = "%-30s %9d %8d %9d %8d %10d\n"
+var tabularShortFormatAverage = "%-20s %9s %9.1f %8s %9s %8s %10.1f\n"
 var shortFormatFileTrucate = 29
 var shortNameTruncate = 20
 
 var tabularShortFormatHeadNoComplexity = "%-22s %11s %11s %9s %11s %10s\n"
 var tabularShortFormatBodyNoComplexity = "%-22s %11d %11d %9d %11d %10d\n"
 var tabularShortFormatFileNoComplexity = "%-34s %11d %9d %11d %10d\n"
+var tabularShortFormatAverageNoComplexity = "%-22s %11s %11.1f\n"
 var shortFormatFileTrucateNoComplexity = 33
 var longNameTruncate = 22
 
 var tabularWideBreak = "─────────────────────────────────────────────────────────────────────────────────────────────────────────────\n"
-var tabularWideFormatHead = "%-33s %9s %9s %8s %9s %8s %10s %16s\n"
-var tabularWideFormatBody = "%-33s %9d %9d %8d %9d %8d %10d %16.2f\n"
-var tabularWideFormatFile = "%-43s %9d %8d %9d %8d %10d %16.2f\n"
-var wideFormatFileTrucate = 42
+var tabularWideFormatHead = "%-23s %9s %9s %8s %9s %8s %10s %9s %16s\n"
+var tabularWideFormatBody = "%-23s %9d %9d %8d %9d %8d %10d %9d %16.2f\n"
+var tabularWideFormatFile = "%-33s %9d %8d %9d %8d %10d %9d %16.2f %10s\n"
+var tabularWideFormatAverage = "%-23s %9s %9.1f %8s %9s %8s %10.1f\n"
+var wideFormatFileTrucate = 32
+
+// The modification date which ends the rows of files in wide output, which the other rows
+// of the table and its break are made wider by
+var tabularWideFormatModified = " %10s"
+var wideModifiedWidth = 11
+
+// Narrower versions of the formats used for --percent which leave room for the percentage at the end
+var tabularShortFormatHeadPercent = "%-18s %7s %9s %8s %8s %6s %10s\n"
+var tabularShortFormatBodyPercent = "%-18s %7d %9d %8d %8d %6d %10d\n"
+var tabularShortFormatFilePercent = "%-26s %9d %8d %8d %6d %10d\n"
+var tabularShortFormatAveragePercent = "%-18s %7s %9.1f %8s %8s %6s %10.1f\n"
+var shortFormatFileTrucatePercent = 25
+var tabularShortFormatHeadNoComplexityPercent = "%-21s %9s %11s %9s %9s %8s\n"
+var tabularShortFormatBodyNoComplexityPercent = "%-21s %9d %11d %9d %9d %8d\n"
+var tabularShortFormatFileNoComplexityPercent = "%-31s %11d %9d %9d %8d\n"
+var tabularShortFormatAverageNoComplexityPercent = "%-21s %9s %11.1f\n"
+var shortFormatFileTrucateNoComplexityPercent = 30
+var shortNameTruncatePercent = 18
+var tabularWideFormatHeadPercent = "%-16s %9s %9s %8s %9s %8s %10s %9s %16s\n"
+var tabularWideFormatBodyPercent = "%-16s %9d %9d %8d %9d %8d %10d %9d %16.2f\n"
+var tabularWideFormatFilePercent = "%-26s %9d %8d %9d %8d %10d %9d %16.2f %10s\n"
+var tabularWideFormatAveragePercent = "%-16s %9s %9.1f %8s %9s %8s %10.1f\n"
+var wideFormatFileTrucatePercent = 25
+var longNameTruncatePercent = 16
+
+var tabularShortFormatSkipped = "%-60s %18s\n"
+var shortFormatSkippedTrucate = 59
+var tabularWideFormatSkipped = "%-90s %18s\n"
+var wideFormatSkippedTrucate = 89
+
+var tabularShortFormatHeadULOC = "%-60s %18s\n"
+var tabularShortFormatULOC = "%-60s %18d\n"
+var tabularWideFormatHeadULOC = "%-90s %18s\n"
+var tabularWideFormatULOC = "%-90s %18d\n"
+
+var tabularShortFormatHeadImports = "%-60s %18s\n"
+var tabularShortFormatImports = "%-60s %18d\n"
+var tabularWideFormatHeadImports = "%-90s %18s\n"
+var tabularWideFormatImports = "%-90s %18d\n"
+
+var tabularShortFormatHeadHistogram = "%-34s %10s %10s %10s %10s\n"
+var tabularShortFormatHistogram = "%-34s %10d %10d %10d %10d\n"
+var tabularWideFormatHeadHistogram = "%-48s %14s %14s %14s %14s\n"
+var tabularWideFormatHistogram = "%-48s %14d %14d %14d %14d\n"
+
+var tabularShortFormatHeadRatio = "%-46s %10s %10s %10s\n"
+var tabularShortFormatRatio = "%-46s %9.1f%% %9.1f%% %9.1f%%\n"
+var tabularWideFormatHeadRatio = "%-76s %10s %10s %10s\n"
+var tabularWideFormatRatio = "%-76s %9.1f%% %9.1f%% %9.1f%%\n"
+var tabularWideFormatHeadLineLength = "%-87s %10s %10s\n"
+var tabularWideFormatLineLength = "%-87s %10.1f %10d\n"
+
+var tabularShortFormatHeadTests = "%-46s %10s %10s %10s\n"
+var tabularShortFormatTests = "%-46s %10d %10d %9.1f%%\n"
+var tabularWideFormatHeadTests = "%-76s %10s %10s %10s\n"
+var tabularWideFormatTests = "%-76s %10d %10d %9.1f%%\n"
+
+var tabularShortFormatHeadLines = "%-50s %13s %14s\n"
+var tabularShortFormatLines = "%-50s %13d %14d\n"
+var tabularShortFormatFileLines = "%-64s %14d\n"
+var shortFormatFileLinesTrucate = 63
+var tabularWideFormatHeadLines = "%-80s %13s %14s\n"
+var tabularWideFormatLines = "%-80s %13d %14d\n"
+var tabularWideFormatFileLines = "%-94s %14d\n"
+var wideFormatFileLinesTrucate = 93
+
+// Shortens a location to fit within width by removing the start of it, which is marked
+// with ~, cutting at a directory where possible so the name of the file is kept whole
+func truncateLocation(location string, width int) string {
+	if len(location) <= width || width < 2 {
+		return location
+	}
 
-func sortSummaryFiles(summary *LanguageSummary) {
+	tmp := location[len(location)-width+1:]
+	if i := strings.IndexAny(tmp, "/\\"); i != -1 {
+		tmp = tmp[i:]
+	}
+
+	return "~" + tmp
+}
+
+// The narrowest the language column is made when a table shrinks to fit the terminal, which
+// is the width of the longest label written in it
+var minNameWidth = len("Average per File")
+
+// Returns the columns of the terminal the output is written to or 0 when it is being
+// redirected, which is a variable so that tests can pretend to write to a terminal
+var outputColumns = func() int {
+	if FileOutput != "" {
+		return 0
+	}
+
+	return terminalWidth(os.Stdout)
+}
+
+// Returns the format for the rows of files along with the width of the name column
+// which is FilenameWidth when set, otherwise the name column grows or shrinks so the
+// rows fill the terminal, falling back to the width the format was written with. It
+// never shrinks so far that the language column of the table heading it goes below minNameWidth
+func fileColumn(format string, truncate int, tabularBreak string, headFormat string) (string, int) {
+	width := FilenameWidth
+	if width <= 0 {
+		columns := outputColumns()
+		if columns <= 0 {
+			return format, truncate + 1
+		}
+
+		// The break is as wide as the rows of the format without the name column changed
+		width = max(truncate+1+columns-utf8.RuneCountInString(strings.TrimSuffix(tabularBreak, "\n")), truncate+1-columnWidth(headFormat)+minNameWidth)
+	}
+
+	return fmt.Sprintf("%%-%ds", width) + format[strings.Index(format, "s")+1:], width
+}
+
+// Returns the width of the first column of a format such as %-20s
+func columnWidth(format string) int {
+	width := 0
+	fmt.Sscanf(format, "%%-%ds", &width)
+	return width
+}
+
+// Returns the format with its first column made wider by columns, or narrower when columns is
+// negative but no narrower than minNameWidth, which is used to keep the language rows of a table
+// in line with the rows of files resized by fileColumn
+func resizeColumn(format string, columns int) string {
+	return fmt.Sprintf("%%-%ds", max(columnWidth(format)+columns, minNameWidth)) + format[strings.Index(format, "s")+1:]
+}
+
+// Returns the break made wider by columns, or narrower when columns is negative
+func resizeBreak(tabularBreak string, columns int) string {
+	return strings.Repeat("─", max(utf8.RuneCountInString(strings.TrimSuffix(tabularBreak, "\n"))+columns, 0)) + "\n"
+}
+
+// The ranges of complexity each file is counted in for the complexity histogram
+var complexityBuckets = []string{"0", "1-5", "6-20", "21+"}
+
+// The complexity density of some code which is treated as 0 when there is no code
+func complexityPerLine(complexity int64, code int64) float64 {
+	if code == 0 {
+		return 0
+	}
+
+	return float64(complexity) / float64(code)
+}
+
+// Returns the value of the column being sorted on for a language where
+// larger values are sorted first
+func languageSortValue(summary LanguageSummary) float64 {
 	switch {
-	case SortBy == "name" || SortBy == "names" || SortBy == "language" || SortBy == "languages":
-		sort.Slice(summary.Files, func(i, j int) bool {
-			return summary.Files[i].Lines > summary.Files[j].Lines
-		})
 	case SortBy == "line" || SortBy == "lines":
-		sort.Slice(summary.Files, func(i, j int) bool {
-			return summary.Files[i].Lines > summary.Files[j].Lines
-		})
+		return float64(summary.Lines)
 	case SortBy == "blank" || SortBy == "blanks":
-		sort.Slice(summary.Files, func(i, j int) bool {
-			return summary.Files[i].Blank > summary.Files[j].Blank
-		})
+		return float64(summary.Blank)
 	case SortBy == "code" || SortBy == "codes":
-		sort.Slice(summary.Files, func(i, j int) bool {
-			return summary.Files[i].Code > summary.Files[j].Code
-		})
+		return float64(summary.Code)
 	case SortBy == "comment" || SortBy == "comments":
-		sort.Slice(summary.Files, func(i, j int) bool {
-			return summary.Files[i].Comment > summary.Files[j].Comment
-		})
+		return float64(summary.Comment)
 	case SortBy == "complexity" || SortBy == "complexitys":
-		sort.Slice(summary.Files, func(i, j int) bool {
-			return summary.Files[i].Complexity > summary.Files[j].Complexity
-		})
-	default:
-		sort.Slice(summary.Files, func(i, j int) bool {
-			return summary.Files[i].Lines > summary.Files[j].Lines
-		})
+		return float64(summary.Complexity)
+	case SortBy == "complexity-per-line" || SortBy == "complexity-per-lines":
+		return complexityPerLine(summary.Complexity, summary.Code)
 	}
+
+	return float64(summary.Count)
 }
 
-func toJson(input chan *FileJob) string {
-	languages := map[string]LanguageSummary{}
-	var sumFiles, sumLines, sumCode, sumComment, sumBlank, sumComplexity int64 = 0, 0, 0, 0, 0, 0
+// Returns the value of the column being sorted on for a file where
+// larger values are sorted first
+func fileSortValue(job *FileJob) float64 {
+	switch {
+	case SortBy == "blank" || SortBy == "blanks":
+		return float64(job.Blank)
+	case SortBy == "code" || SortBy == "codes":
+		return float64(job.Code)
+	case SortBy == "comment" || SortBy == "comments":
+		return float64(job.Comment)
+	case SortBy == "complexity" || SortBy == "complexitys":
+		return float64(job.Complexity)
+	case SortBy == "complexity-per-line" || SortBy == "complexity-per-lines":
+		return complexityPerLine(job.Complexity, job.Code)
+	}
 
-	for res := range input {
-		sumFiles++
-		sumLines += res.Lines
-		sumCode += res.Code
-		sumComment += res.Comment
-		sumBlank += res.Blank
-		sumComplexity += res.Complexity
+	return float64(job.Lines)
+}
 
-		_, ok := languages[res.Language]
+// Cater for the common case of adding plural even for those options that don't make sense
+// as its quite common for those who English is not a first language to make a simple mistake
+func isSortByName() bool {
+	return SortBy == "name" || SortBy == "names" || SortBy == "language" || SortBy == "languages"
+}
 
-		if !ok {
-			files := []*FileJob{}
-			files = append(files, res)
+// Sorts the languages by the column requested falling back to the name when
+// they are equal so the output is the same between runs, all reversed for Reverse
+func sortLanguageSummary(language []LanguageSummary) {
+	sort.Slice(language, func(i, j int) bool {
+		if Reverse {
+			i, j = j, i
+		}
 
-			languages[res.Language] = LanguageSummary{
-				Name:       res.Language,
-				Lines:      res.Lines,
-				Code:       res.Code,
-				Comment:    res.Comment,
-				Blank:      res.Blank,
-				Complexity: res.Complexity,
-				Count:      1,
-				Files:      files,
+		if !isSortByName() {
+			iValue, jValue := languageSortValue(language[i]), languageSortValue(language[j])
+			if iValue != jValue {
+				return iValue > jValue
 			}
-		} else {
-			tmp := languages[res.Language]
-			files := append(tmp.Files, res)
-
-			languages[res.Language] = LanguageSummary{
-				Name:       res.Language,
-				Lines:      tmp.Lines + res.Lines,
-				Code:       tmp.Code + res.Code,
-				Comment:    tmp.Comment + res.Comment,
-				Blank:      tmp.Blank + res.Blank,
-				Complexity: tmp.Complexity + res.Complexity,
-				Count:      tmp.Count + 1,
-				Files:      files,
+		}
+
+		return lessName(language[i].Name, language[j].Name)
+	})
+}
+
+// Sorts the files of a language by the column requested, or by location when sorting by name,
+// falling back to the location when they are equal so the output is the same between runs, all reversed for Reverse
+func sortSummaryFiles(summary *LanguageSummary) {
+	sort.Slice(summary.Files, func(i, j int) bool {
+		if Reverse {
+			i, j = j, i
+		}
+
+		if !isSortByName() {
+			iValue, jValue := fileSortValue(summary.Files[i]), fileSortValue(summary.Files[j])
+			if iValue != jValue {
+				return iValue > jValue
 			}
 		}
+
+		return lessName(summary.Files[i].Location, summary.Files[j].Location)
+	})
+}
+
+// Orders names alphabetically ignoring case as printLanguages does, falling back to
+// the exact name so names which only differ by case are in the same order every run
+func lessName(a string, b string) bool {
+	if compare := strings.Compare(strings.ToLower(a), strings.ToLower(b)); compare != 0 {
+		return compare < 0
+	}
+
+	return a < b
+}
+
+// The version of the JSON output which is increased whenever a field is removed or renamed
+// or what it means changes, so that anything reading the output can detect the change.
+// Version 2 sets WeightedComplexity for the languages and files where it was always 0
+const JsonSchemaVersion = 2
+
+// Top level object written out when the output format is JSON
+type jsonSummary struct {
+	SchemaVersion     int `json:"schemaVersion"`
+	Languages         []LanguageSummary
+	Total             Total
+	Skipped           []SkippedFile      `json:",omitempty"`
+	Duplicates        [][]string         `json:",omitempty"`
+	ComplexityBuckets []string           `json:",omitempty"`
+	Directories       []directorySummary `json:",omitempty"`
+}
+
+// What the percentages of --percent are of
+const (
+	PercentCode  = "code"
+	PercentLines = "lines"
+)
+
+// Returns the code or lines depending on what PercentOf is set to
+func percentCount(code int64, lines int64) int64 {
+	if PercentOf == PercentLines {
+		return lines
+	}
+
+	return code
+}
+
+// The share of the total which the count is as a percentage which is 0 when the total is 0
+func percentage(count int64, total int64) float64 {
+	if total == 0 {
+		return 0
+	}
+
+	return float64(count) / float64(total) * 100
+}
+
+// Adds the percentage column to the end of a row of tabular output when using --percent
+func withPercent(row string, count int64, total int64) string {
+	if !Percent {
+		return row
+	}
+
+	return strings.TrimSuffix(row, "\n") + fmt.Sprintf(" %5.1f%%\n", percentage(count, total))
+}
+
+// Adds the Modified column which ends the rows of files in wide output to another row of the
+// table when the files are shown. The value is empty other than in the heading so it is only
+// added then or when the percentage follows it, as otherwise the row can end before it
+func withModified(row string, value string) string {
+	if !Files || (value == "" && !Percent) {
+		return row
+	}
+
+	return strings.TrimSuffix(row, "\n") + fmt.Sprintf(tabularWideFormatModified, value) + "\n"
+}
+
+// Adds the heading of the percentage column to the end of the heading of tabular output
+func withPercentHead(row string) string {
+	if !Percent {
+		return row
+	}
+
+	heading := "%Code"
+	if PercentOf == PercentLines {
+		heading = "%Lines"
+	}
+
+	return strings.TrimSuffix(row, "\n") + fmt.Sprintf(" %6s\n", heading)
+}
+
+// The average of a sum over some number of files which is 0 when there are no files
+func average(sum int64, files int64) float64 {
+	if files == 0 {
+		return 0
+	}
+
+	return float64(sum) / float64(files)
+}
+
+// Consumes the input building a summary for each language including the files
+// which belong to it, sorted by whatever the user has requested
+func aggregateLanguageSummary(input chan *FileJob) []LanguageSummary {
+	languages := map[string]LanguageSummary{}
+
+	for res := range input {
+		tmp := languages[res.Language]
+
+		res.WeightedComplexity = 0
+		if res.Code != 0 {
+			res.WeightedComplexity = (float64(res.Complexity) / float64(res.Code)) * 100
+		}
+
+		maxLineLength := tmp.MaxLineLength
+		if res.MaxLineLength > maxLineLength {
+			maxLineLength = res.MaxLineLength
+		}
+
+		// Each section of a file counts towards the files of its language but only one
+		// of them counts towards the total as they are all the one file
+		sections := tmp.sections
+		if res.extraSection {
+			sections++
+		}
+
+		languages[res.Language] = LanguageSummary{
+			Name:               res.Language,
+			Bytes:              tmp.Bytes + res.Bytes,
+			Lines:              tmp.Lines + res.Lines,
+			Code:               tmp.Code + res.Code,
+			Comment:            tmp.Comment + res.Comment,
+			Blank:              tmp.Blank + res.Blank,
+			Complexity:         tmp.Complexity + res.Complexity,
+			Tokens:             tmp.Tokens + res.Tokens,
+			Imports:            tmp.Imports + res.Imports,
+			Count:              tmp.Count + 1,
+			WeightedComplexity: tmp.WeightedComplexity + res.WeightedComplexity,
+			MaxLineLength:      maxLineLength,
+			Files:              append(tmp.Files, res),
+			lineLengthSum:      tmp.lineLengthSum + res.lineLengthSum,
+			lineLengthLines:    tmp.lineLengthLines + res.lineLengthLines,
+			sections:           sections,
+		}
 	}
 
 	language := []LanguageSummary{}
 	for _, summary := range languages {
+		sortSummaryFiles(&summary)
+		summary.AverageLines = average(summary.Lines, summary.Count)
+		summary.AverageComplexity = average(summary.Complexity, summary.Count)
+		// The ratios are only set when asked for so that a ratio of 0 is still written
+		if Ratios {
+			blank, comment, code := ratios(summary)
+			summary.BlankRatio, summary.CommentRatio, summary.CodeRatio = &blank, &comment, &code
+		}
+		summary.AverageLineLength = average(summary.lineLengthSum, summary.lineLengthLines)
+		if ULOC {
+			summary.ULOC = uniqueLines.Language(summary.Name)
+		}
+		if ComplexityHistogram {
+			summary.ComplexityHistogram = complexityHistogram(summary.Files)
+		}
 		language = append(language, summary)
 	}
+	sortLanguageSummary(language)
+
+	return language
+}
+
+// Adds up the summaries of the languages along with the skipped files and unique lines
+func aggregateTotal(language []LanguageSummary) Total {
+	total := Total{}
+
+	for _, summary := range language {
+		total.Files += summary.Count - summary.sections
+		total.Lines += summary.Lines
+		total.Code += summary.Code
+		total.Comment += summary.Comment
+		total.Blank += summary.Blank
+		total.Complexity += summary.Complexity
+		total.Tokens += summary.Tokens
+		total.Imports += summary.Imports
+	}
+
+	total.AverageLines = average(total.Lines, total.Files)
+	total.AverageComplexity = average(total.Complexity, total.Files)
+
+	total.Skipped = skipped.Count()
+	if ULOC {
+		total.ULOC = uniqueLines.Total()
+	}
+
+	return total
+}
+
+// Builds the summary written out by the JSON and YAML formats so both have the same structure
+func buildJsonSummary(input chan *FileJob) jsonSummary {
+	language := aggregateLanguageSummary(input)
+	total := aggregateTotal(language)
+
+	var directories []directorySummary
+	if ByDirectory > 0 {
+		directories = aggregateDirectories(language, ByDirectory)
+	}
+
+	for i := range language {
+		language[i].Percentage = percentage(percentCount(language[i].Code, language[i].Lines), percentCount(total.Code, total.Lines))
+
+		// Only include the per file breakdown when asked for as it can be very large
+		if !Files {
+			language[i].Files = nil
+		}
+	}
+
+	var skippedFiles []SkippedFile
+	if ShowSkipped {
+		skippedFiles = skipped.Files()
+	}
+
+	var duplicateGroups [][]string
+	if DuplicateGroups {
+		duplicateGroups = duplicates.Groups()
+	}
+
+	var buckets []string
+	if ComplexityHistogram {
+		buckets = complexityBuckets
+	}
+
+	return jsonSummary{
+		SchemaVersion:     JsonSchemaVersion,
+		Languages:         language,
+		Total:             total,
+		Skipped:           skippedFiles,
+		Duplicates:        duplicateGroups,
+		ComplexityBuckets: buckets,
+		Directories:       directories,
+	}
+}
+
+// Returns what is written by the JSON and YAML formats which is only the total for --total-only
+func jsonOutput(summary jsonSummary) interface{} {
+	if TotalOnly {
+		return summary.Total
+	}
+
+	return summary
+}
+
+func toJson(input chan *FileJob) string {
+	summary := buildJsonSummary(input)
 
 	startTime := makeTimestampMilli()
-	jsonString, _ := json.Marshal(language)
+	jsonString, _ := json.Marshal(jsonOutput(summary))
 
 	if Debug {
 		printDebug(fmt.Sprintf("milliseconds to build formatted string: %d", makeTimestampMilli()-startTime))
@@ -124,28 +522,291 @@ func toJson(input chan *FileJob) string {
 	return string(jsonString)
 }
 
+// The parsed FormatTemplate which when set is used rather than Format
+var formatTemplate *template.Template
+
+// Parses the text/template file used to write the output
+func parseFormatTemplate(name string) (*template.Template, error) {
+	content, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+
+	return template.New(filepath.Base(name)).Parse(string(content))
+}
+
+// Writes the output using FormatTemplate which is given the same summary as the JSON format
+func toTemplate(input chan *FileJob) (string, error) {
+	summary := buildJsonSummary(input)
+
+	startTime := makeTimestampMilli()
+	var str strings.Builder
+	if err := formatTemplate.Execute(&str, summary); err != nil {
+		return "", fmt.Errorf("unable to execute format template: %s", err)
+	}
+
+	if Debug {
+		printDebug(fmt.Sprintf("milliseconds to build formatted string: %d", makeTimestampMilli()-startTime))
+	}
+
+	return str.String(), nil
+}
+
+// Writes the same structure as the JSON format with identical keys by converting the JSON
+// which saves keeping the struct tags and a separate YAML encoder in step
+func toYaml(input chan *FileJob) string {
+	summary := buildJsonSummary(input)
+
+	startTime := makeTimestampMilli()
+	jsonString, _ := json.Marshal(jsonOutput(summary))
+	yaml, _ := jsonToYaml(jsonString)
+
+	if Debug {
+		printDebug(fmt.Sprintf("milliseconds to build formatted string: %d", makeTimestampMilli()-startTime))
+	}
+
+	return yaml
+}
+
+// A JSON object which keeps the order of its keys
+type yamlMap []yamlEntry
+
+type yamlEntry struct {
+	key   string
+	value interface{}
+}
+
+// A JSON array
+type yamlList []interface{}
+
+// Converts the JSON to block style YAML keeping the order of the keys. Strings are always
+// double quoted using the JSON escapes which are also valid YAML escapes
+func jsonToYaml(input []byte) (string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	decoder.UseNumber()
+
+	node, err := decodeYamlNode(decoder)
+	if err != nil {
+		return "", err
+	}
+
+	var str strings.Builder
+	writeYamlNode(&str, node, "", "")
+	return str.String(), nil
+}
+
+// Decodes the next JSON value into a yamlMap, yamlList or the string of a scalar
+func decodeYamlNode(decoder *json.Decoder) (interface{}, error) {
+	token, err := decoder.Token()
+	if err != nil {
+		return nil, err
+	}
+
+	switch value := token.(type) {
+	case json.Delim:
+		if value == '{' {
+			node := yamlMap{}
+			for decoder.More() {
+				key, err := decoder.Token()
+				if err != nil {
+					return nil, err
+				}
+
+				child, err := decodeYamlNode(decoder)
+				if err != nil {
+					return nil, err
+				}
+
+				node = append(node, yamlEntry{key: fmt.Sprint(key), value: child})
+			}
+			_, err := decoder.Token()
+
+			if len(node) == 0 {
+				return "{}", err
+			}
+			return node, err
+		}
+
+		node := yamlList{}
+		for decoder.More() {
+			child, err := decodeYamlNode(decoder)
+			if err != nil {
+				return nil, err
+			}
+			node = append(node, child)
+		}
+		_, err := decoder.Token()
+
+		if len(node) == 0 {
+			return "[]", err
+		}
+		return node, err
+	case string:
+		quoted, _ := json.Marshal(value)
+		return string(quoted), nil
+	case json.Number:
+		return value.String(), nil
+	case bool:
+		return strconv.FormatBool(value), nil
+	}
+
+	return "null", nil
+}
+
+// Writes the node with its first line starting with first and any others with indent
+func writeYamlNode(str *strings.Builder, node interface{}, first string, indent string) {
+	switch value := node.(type) {
+	case yamlMap:
+		for i, entry := range value {
+			prefix := indent
+			if i == 0 {
+				prefix = first
+			}
+
+			switch child := entry.value.(type) {
+			case yamlMap:
+				str.WriteString(prefix + entry.key + ":\n")
+				writeYamlNode(str, child, indent+"  ", indent+"  ")
+			case yamlList:
+				str.WriteString(prefix + entry.key + ":\n")
+				writeYamlNode(str, child, indent, indent)
+			default:
+				str.WriteString(fmt.Sprintf("%s%s: %s\n", prefix, entry.key, child))
+			}
+		}
+	case yamlList:
+		for i, item := range value {
+			prefix := indent
+			if i == 0 {
+				prefix = first
+			}
+
+			switch item.(type) {
+			case yamlMap, yamlList:
+				writeYamlNode(str, item, prefix+"- ", indent+"  ")
+			default:
+				str.WriteString(fmt.Sprintf("%s- %s\n", prefix, item))
+			}
+		}
+	default:
+		str.WriteString(fmt.Sprintf("%s%s\n", first, value))
+	}
+}
+
+// Line written for each file when the output format is ndjson
+type ndjsonFile struct {
+	Type string
+	*FileJob
+}
+
+// Final line written when the output format is ndjson
+type ndjsonTotal struct {
+	Type string
+	Total
+}
+
+// Writes a JSON object per line for each file as it is received so that nothing
+// needs to be buffered, followed by the totals. Each line has a Type of either file
+// or total so they can be told apart
+func toNdjson(input chan *FileJob, output io.Writer) {
+	encoder := json.NewEncoder(output)
+	total := Total{}
+
+	for res := range input {
+		if !res.extraSection {
+			total.Files++
+		}
+		total.Lines += res.Lines
+		total.Code += res.Code
+		total.Comment += res.Comment
+		total.Blank += res.Blank
+		total.Complexity += res.Complexity
+
+		encoder.Encode(ndjsonFile{Type: "file", FileJob: res})
+	}
+
+	if FilesOnly {
+		return
+	}
+
+	total.Skipped = skipped.Count()
+	if ULOC {
+		total.ULOC = uniqueLines.Total()
+	}
+	encoder.Encode(ndjsonTotal{Type: "total", Total: total})
+}
+
+// Writes a JSON array of the files as they are received for --files-only so nothing is
+// buffered or added up, which leaves any aggregation to whatever reads the output
+func toJsonFiles(input chan *FileJob, output io.Writer) {
+	io.WriteString(output, "[")
+
+	separator := "\n"
+	for res := range input {
+		jsonString, _ := json.Marshal(res)
+		io.WriteString(output, separator)
+		output.Write(jsonString)
+		separator = ",\n"
+	}
+
+	io.WriteString(output, "\n]\n")
+}
+
 func toCSV(input chan *FileJob) string {
+	language := aggregateLanguageSummary(input)
+
 	records := [][]string{{
 		"Language",
-		"Location",
-		"Filename",
+		"Files",
 		"Lines",
-		"Code",
-		"Comments",
 		"Blanks",
-		"Complexity"},
+		"Comments",
+		"Code",
+		"Complexity",
+		"Average Lines",
+		"Average Complexity"},
 	}
 
-	for result := range input {
+	for _, summary := range language {
 		records = append(records, []string{
-			result.Language,
-			result.Location,
-			result.Filename,
-			fmt.Sprint(result.Lines),
-			fmt.Sprint(result.Code),
-			fmt.Sprint(result.Comment),
-			fmt.Sprint(result.Blank),
-			fmt.Sprint(result.Complexity)})
+			summary.Name,
+			fmt.Sprint(summary.Count),
+			fmt.Sprint(summary.Lines),
+			fmt.Sprint(summary.Blank),
+			fmt.Sprint(summary.Comment),
+			fmt.Sprint(summary.Code),
+			fmt.Sprint(summary.Complexity),
+			strconv.FormatFloat(summary.AverageLines, 'f', 2, 64),
+			strconv.FormatFloat(summary.AverageComplexity, 'f', 2, 64)})
+	}
+
+	// The per file section is separated from the language section by an empty line
+	// so that it can be split off easily by anything consuming it
+	if Files {
+		records = append(records, []string{}, []string{
+			"Filename",
+			"Language",
+			"Lines",
+			"Code",
+			"Comments",
+			"Blanks",
+			"Complexity",
+			"Modified"},
+		)
+
+		for _, summary := range language {
+			for _, result := range summary.Files {
+				records = append(records, []string{
+					result.Location,
+					result.Language,
+					fmt.Sprint(result.Lines),
+					fmt.Sprint(result.Code),
+					fmt.Sprint(result.Comment),
+					fmt.Sprint(result.Blank),
+					fmt.Sprint(result.Complexity),
+					formatModTime(result.ModTime, time.RFC3339)})
+			}
+		}
 	}
 
 	b := &bytes.Buffer{}
@@ -153,150 +814,384 @@ func toCSV(input chan *FileJob) string {
 	w.WriteAll(records)
 	w.Flush()
 
-	return b.String()
+	return b.String()
+}
+
+// Quotes a string so it can be used as an SQL identifier such as a table name
+func sqlIdentifier(value string) string {
+	return `"` + strings.Replace(value, `"`, `""`, -1) + `"`
+}
+
+// Quotes a string so it can be used as an SQL string literal
+func sqlString(value string) string {
+	return "'" + strings.Replace(value, "'", "''", -1) + "'"
+}
+
+func toSQL(input chan *FileJob) string {
+	language := aggregateLanguageSummary(input)
+
+	var str strings.Builder
+	table := sqlIdentifier(SQLTable)
+	runId := sqlString(strconv.FormatInt(makeTimestampNano(), 36))
+	timestamp := sqlString(getFormattedTime())
+
+	// Language rows have no location and a file count, while file rows have a location and a count of 1
+	str.WriteString(fmt.Sprintf("create table if not exists %s (run_id text not null, timestamp text not null, language text not null, location text, files integer, lines integer, code integer, comments integer, blanks integer, complexity integer);\n", table))
+	str.WriteString("begin transaction;\n")
+
+	insert := "insert into %s (run_id, timestamp, language, location, files, lines, code, comments, blanks, complexity) values (%s, %s, %s, %s, %d, %d, %d, %d, %d, %d);\n"
+	for _, summary := range language {
+		str.WriteString(fmt.Sprintf(insert, table, runId, timestamp, sqlString(summary.Name), "null", summary.Count, summary.Lines, summary.Code, summary.Comment, summary.Blank, summary.Complexity))
+
+		if Files {
+			for _, res := range summary.Files {
+				str.WriteString(fmt.Sprintf(insert, table, runId, timestamp, sqlString(res.Language), sqlString(res.Location), 1, res.Lines, res.Code, res.Comment, res.Blank, res.Complexity))
+			}
+		}
+	}
+
+	str.WriteString("commit;\n")
+	return str.String()
+}
+
+// Writes the lines of each file then the total in the same way that wc -l does
+// with the counts right aligned to the width of the total
+func toWc(input chan *FileJob) string {
+	var files []*FileJob
+	var sumLines int64
+
+	for res := range input {
+		sumLines += res.Lines
+		if Files {
+			files = append(files, res)
+		}
+	}
+
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Location < files[j].Location
+	})
+
+	var str strings.Builder
+	width := len(strconv.FormatInt(sumLines, 10))
+	for _, res := range files {
+		str.WriteString(fmt.Sprintf("%*d %s\n", width, res.Lines, res.Location))
+	}
+	str.WriteString(fmt.Sprintf("%*d total\n", width, sumLines))
+
+	return str.String()
+}
+
+// Escapes a label value of the OpenMetrics text format where backslashes,
+// double quotes and newlines are the only characters which need escaping
+func openMetricsLabel(value string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(value)
+}
+
+// Writes a gauge for each of the counts of each language in the OpenMetrics text format which
+// Prometheus can scrape. The totals are the same gauges without a language label
+func toOpenMetrics(input chan *FileJob) string {
+	language := aggregateLanguageSummary(input)
+
+	metrics := []struct {
+		name  string
+		help  string
+		value func(LanguageSummary) int64
+	}{
+		{"scc_files", "Number of files counted.", func(s LanguageSummary) int64 { return s.Count }},
+		{"scc_bytes", "Number of bytes counted.", func(s LanguageSummary) int64 { return s.Bytes }},
+		{"scc_lines", "Number of lines.", func(s LanguageSummary) int64 { return s.Lines }},
+		{"scc_code_lines", "Number of lines of code.", func(s LanguageSummary) int64 { return s.Code }},
+		{"scc_comment_lines", "Number of lines of comments.", func(s LanguageSummary) int64 { return s.Comment }},
+		{"scc_blank_lines", "Number of blank lines.", func(s LanguageSummary) int64 { return s.Blank }},
+		{"scc_complexity", "Complexity of the code.", func(s LanguageSummary) int64 { return s.Complexity }},
+	}
+
+	var str strings.Builder
+	for _, metric := range metrics {
+		str.WriteString(fmt.Sprintf("# TYPE %s gauge\n", metric.name))
+		str.WriteString(fmt.Sprintf("# HELP %s %s\n", metric.name, metric.help))
+
+		var total int64
+		for _, summary := range language {
+			value := metric.value(summary)
+			total += value
+			str.WriteString(fmt.Sprintf("%s{language=\"%s\"} %d\n", metric.name, openMetricsLabel(summary.Name), value))
+		}
+		str.WriteString(fmt.Sprintf("%s %d\n", metric.name, total))
+	}
+	str.WriteString("# EOF\n")
+
+	return str.String()
+}
+
+// Writes the output using the configured format, which only fails for FormatTemplate. When
+// MinCommentRatio is set the summary of each language the output was written from is returned
+// as well so it can be checked once the output has been written, and otherwise it is nil
+func fileSummarize(input chan *FileJob) (string, []LanguageSummary, error) {
+	var files []*FileJob
+	if MinCommentRatio > 0 {
+		input = withFileProcessed(input, func(res *FileJob) {
+			files = append(files, res)
+		})
+	}
+
+	result, err := traceSummary(input)
+	if err != nil || MinCommentRatio <= 0 {
+		return result, nil, err
+	}
+
+	summarised := make(chan *FileJob, len(files))
+	for _, res := range files {
+		summarised <- res
+	}
+	close(summarised)
+
+	return result, aggregateLanguageSummary(summarised), nil
+}
+
+// Formats the summary printing the throughput of the run when Trace is set
+func traceSummary(input chan *FileJob) (string, error) {
+	if !Trace {
+		return formatSummary(input)
+	}
+
+	// Count everything passing through to the formatter to work out the throughput of the
+	// whole run, which shows if adding workers helps or if the disk is the limit
+	startTime := makeTimestampNano()
+	counted := make(chan *FileJob, FileSummaryJobQueueSize)
+	var sumFiles, sumBytes int64
+
+	go func() {
+		for res := range input {
+			sumFiles++
+			sumBytes += res.Bytes
+			counted <- res
+		}
+		close(counted)
+	}()
+
+	result, err := formatSummary(counted)
+
+	elapsed := makeTimestampNano() - startTime
+	printTrace(fmt.Sprintf("files processed: %d bytes processed: %d nanoseconds: %d throughput: %.2f MB/s", sumFiles, sumBytes, elapsed, throughput(sumBytes, elapsed)))
+
+	return result, err
+}
+
+// Megabytes per second for the bytes processed in the nanoseconds
+func throughput(bytes int64, nanoseconds int64) float64 {
+	if nanoseconds <= 0 {
+		return 0
+	}
+
+	return float64(bytes) / (1024 * 1024) / (float64(nanoseconds) / float64(time.Second))
 }
 
-func fileSummarize(input chan *FileJob) string {
+func formatSummary(input chan *FileJob) (string, error) {
 	switch {
+	case formatTemplate != nil:
+		return toTemplate(input)
+	case TotalOnly && (More || strings.ToLower(Format) == "wide"):
+		return totalSummarize(input, true), nil
+	case LinesOnly && (More || strings.ToLower(Format) == "wide"):
+		return linesSummarize(input, true), nil
 	case More || strings.ToLower(Format) == "wide":
-		return fileSummarizeLong(input)
+		return fileSummarizeLong(input), nil
+	case FilesOnly && strings.ToLower(Format) == "json":
+		var str strings.Builder
+		toJsonFiles(input, &str)
+		return str.String(), nil
 	case strings.ToLower(Format) == "json":
-		return toJson(input)
+		return toJson(input), nil
+	case strings.ToLower(Format) == "yaml":
+		return toYaml(input), nil
+	case strings.ToLower(Format) == "html":
+		return toHtml(input), nil
 	case strings.ToLower(Format) == "csv":
-		return toCSV(input)
+		return toCSV(input), nil
+	case strings.ToLower(Format) == "sql":
+		return toSQL(input), nil
+	case strings.ToLower(Format) == "wc":
+		return toWc(input), nil
+	case strings.ToLower(Format) == "openmetrics":
+		return toOpenMetrics(input), nil
+	case strings.ToLower(Format) == "ndjson":
+		var str strings.Builder
+		toNdjson(input, &str)
+		return str.String(), nil
+	case LinesOnly:
+		return linesSummarize(input, false), nil
+	case TotalOnly:
+		return totalSummarize(input, false), nil
 	}
 
-	return fileSummarizeShort(input)
+	return fileSummarizeShort(input), nil
 }
 
-func fileSummarizeLong(input chan *FileJob) string {
+// Writes the files and lines of each language for --lines-only where nothing else is counted
+func linesSummarize(input chan *FileJob, wide bool) string {
+	tabularBreak, headFormat, format, fileFormat, truncate := tabularShortBreak, tabularShortFormatHeadLines, tabularShortFormatLines, tabularShortFormatFileLines, shortFormatFileLinesTrucate
+	skippedFormat, skippedTruncate := tabularShortFormatSkipped, shortFormatSkippedTrucate
+	if wide {
+		tabularBreak, headFormat, format, fileFormat, truncate = tabularWideBreak, tabularWideFormatHeadLines, tabularWideFormatLines, tabularWideFormatFileLines, wideFormatFileLinesTrucate
+		skippedFormat, skippedTruncate = tabularWideFormatSkipped, wideFormatSkippedTrucate
+	}
+
 	var str strings.Builder
 
-	str.WriteString(tabularWideBreak)
-	str.WriteString(fmt.Sprintf(tabularWideFormatHead, "Language", "Files", "Lines", "Code", "Comments", "Blanks", "Complexity", "Complexity/Lines"))
+	// The rest of the table is resized along with the rows of files so that they line up
+	tableBreak, width := tabularBreak, truncate+1
+	if Files {
+		fileFormat, width = fileColumn(fileFormat, truncate, tabularBreak, headFormat)
+		columns := width - truncate - 1
+		tableBreak, headFormat, format = resizeBreak(tabularBreak, columns), resizeColumn(headFormat, columns), resizeColumn(format, columns)
+	}
 
+	str.WriteString(tableBreak)
+	str.WriteString(fmt.Sprintf(headFormat, "Language", "Files", "Lines"))
 	if !Files {
-		str.WriteString(tabularWideBreak)
+		str.WriteString(tableBreak)
 	}
 
-	languages := map[string]LanguageSummary{}
-	var sumFiles, sumLines, sumCode, sumComment, sumBlank, sumComplexity int64 = 0, 0, 0, 0, 0, 0
-	var sumWeightedComplexity float64 = 0
+	var sumFiles, sumLines int64
+	for _, summary := range aggregateLanguageSummary(input) {
+		sumFiles += summary.Count - summary.sections
+		sumLines += summary.Lines
+
+		if Files {
+			str.WriteString(tableBreak)
+		}
+
+		str.WriteString(fmt.Sprintf(format, summary.Name, summary.Count, summary.Lines))
+
+		if Files {
+			str.WriteString(tableBreak)
+
+			for _, res := range summary.Files {
+				str.WriteString(fmt.Sprintf(fileFormat, truncateLocation(res.Location, width), res.Lines))
+			}
+		}
+	}
+
+	str.WriteString(tableBreak)
+	str.WriteString(fmt.Sprintf(format, "Total", sumFiles, sumLines))
+	str.WriteString(tableBreak)
+
+	if ShowSkipped {
+		skippedSummarize(&str, tabularBreak, skippedFormat, skippedTruncate)
+	}
+
+	return str.String()
+}
+
+// Writes only the total of every file for --total-only where the languages are not wanted
+func totalSummarize(input chan *FileJob, wide bool) string {
+	var sumFiles, sumLines, sumCode, sumComment, sumBlank, sumComplexity, sumTokens int64
+	var sumWeightedComplexity float64
 
 	for res := range input {
-		sumFiles++
+		if !res.extraSection {
+			sumFiles++
+		}
 		sumLines += res.Lines
 		sumCode += res.Code
 		sumComment += res.Comment
 		sumBlank += res.Blank
 		sumComplexity += res.Complexity
+		sumTokens += res.Tokens
 
-		var weightedComplexity float64 = 0
 		if res.Code != 0 {
-			weightedComplexity = (float64(res.Complexity) / float64(res.Code)) * 100
+			sumWeightedComplexity += (float64(res.Complexity) / float64(res.Code)) * 100
 		}
-		res.WeightedComplexity = weightedComplexity
-		sumWeightedComplexity += weightedComplexity
+	}
 
-		_, ok := languages[res.Language]
+	var str strings.Builder
 
-		if !ok {
-			files := []*FileJob{}
-			files = append(files, res)
+	switch {
+	case wide:
+		str.WriteString(tabularWideBreak)
+		str.WriteString(fmt.Sprintf(tabularWideFormatHead, "", "Files", "Lines", "Code", "Comments", "Blanks", "Complexity", "Tokens", "Complexity/Lines"))
+		str.WriteString(tabularWideBreak)
+		str.WriteString(fmt.Sprintf(tabularWideFormatBody, "Total", sumFiles, sumLines, sumCode, sumComment, sumBlank, sumComplexity, sumTokens, sumWeightedComplexity))
+		str.WriteString(tabularWideBreak)
+	case !Complexity:
+		str.WriteString(tabularShortBreak)
+		str.WriteString(fmt.Sprintf(tabularShortFormatHead, "", "Files", "Lines", "Code", "Comments", "Blanks", "Complexity"))
+		str.WriteString(tabularShortBreak)
+		str.WriteString(fmt.Sprintf(tabularShortFormatBody, "Total", sumFiles, sumLines, sumCode, sumComment, sumBlank, sumComplexity))
+		str.WriteString(tabularShortBreak)
+	default:
+		str.WriteString(tabularShortBreak)
+		str.WriteString(fmt.Sprintf(tabularShortFormatHeadNoComplexity, "", "Files", "Lines", "Code", "Comments", "Blanks"))
+		str.WriteString(tabularShortBreak)
+		str.WriteString(fmt.Sprintf(tabularShortFormatBodyNoComplexity, "Total", sumFiles, sumLines, sumCode, sumComment, sumBlank))
+		str.WriteString(tabularShortBreak)
+	}
 
-			languages[res.Language] = LanguageSummary{
-				Name:               res.Language,
-				Lines:              res.Lines,
-				Code:               res.Code,
-				Comment:            res.Comment,
-				Blank:              res.Blank,
-				Complexity:         res.Complexity,
-				Count:              1,
-				WeightedComplexity: weightedComplexity,
-				Files:              files,
-			}
-		} else {
-			tmp := languages[res.Language]
-			files := append(tmp.Files, res)
-
-			languages[res.Language] = LanguageSummary{
-				Name:               res.Language,
-				Lines:              tmp.Lines + res.Lines,
-				Code:               tmp.Code + res.Code,
-				Comment:            tmp.Comment + res.Comment,
-				Blank:              tmp.Blank + res.Blank,
-				Complexity:         tmp.Complexity + res.Complexity,
-				Count:              tmp.Count + 1,
-				WeightedComplexity: tmp.WeightedComplexity + weightedComplexity,
-				Files:              files,
-			}
-		}
+	return str.String()
+}
+
+func fileSummarizeLong(input chan *FileJob) string {
+	var str strings.Builder
+
+	headFormat, bodyFormat, fileFormat, averageFormat, nameTruncate, fileTruncate := tabularWideFormatHead, tabularWideFormatBody, tabularWideFormatFile, tabularWideFormatAverage, longNameTruncate, wideFormatFileTrucate
+	if Percent {
+		headFormat, bodyFormat, fileFormat, averageFormat, nameTruncate, fileTruncate = tabularWideFormatHeadPercent, tabularWideFormatBodyPercent, tabularWideFormatFilePercent, tabularWideFormatAveragePercent, longNameTruncatePercent, wideFormatFileTrucatePercent
 	}
 
-	language := []LanguageSummary{}
-	for _, summary := range languages {
-		language = append(language, summary)
+	// The rest of the table is resized along with the rows of files so that they line up, with
+	// the Modified column of the files added to the end of the other rows
+	tableBreak, width := tabularWideBreak, fileTruncate+1
+	if Files {
+		tableBreak = resizeBreak(tabularWideBreak, wideModifiedWidth)
+		fileFormat, width = fileColumn(fileFormat, fileTruncate, tableBreak, headFormat)
+		columns := width - fileTruncate - 1
+		nameTruncate += columnWidth(resizeColumn(headFormat, columns)) - columnWidth(headFormat)
+		tableBreak, headFormat, bodyFormat, averageFormat = resizeBreak(tableBreak, columns), resizeColumn(headFormat, columns), resizeColumn(bodyFormat, columns), resizeColumn(averageFormat, columns)
 	}
 
-	// Cater for the common case of adding plural even for those options that don't make sense
-	// as its quite common for those who English is not a first language to make a simple mistake
-	switch {
-	case SortBy == "name" || SortBy == "names" || SortBy == "language" || SortBy == "languages":
-		sort.Slice(language, func(i, j int) bool {
-			return strings.Compare(language[i].Name, language[j].Name) < 0
-		})
-	case SortBy == "line" || SortBy == "lines":
-		sort.Slice(language, func(i, j int) bool {
-			return language[i].Lines > language[j].Lines
-		})
-	case SortBy == "blank" || SortBy == "blanks":
-		sort.Slice(language, func(i, j int) bool {
-			return language[i].Blank > language[j].Blank
-		})
-	case SortBy == "code" || SortBy == "codes":
-		sort.Slice(language, func(i, j int) bool {
-			return language[i].Code > language[j].Code
-		})
-	case SortBy == "comment" || SortBy == "comments":
-		sort.Slice(language, func(i, j int) bool {
-			return language[i].Comment > language[j].Comment
-		})
-	case SortBy == "complexity" || SortBy == "complexitys":
-		sort.Slice(language, func(i, j int) bool {
-			return language[i].Complexity > language[j].Complexity
-		})
-	default:
-		sort.Slice(language, func(i, j int) bool {
-			return language[i].Count > language[j].Count
-		})
+	str.WriteString(tableBreak)
+	str.WriteString(withPercentHead(withModified(fmt.Sprintf(headFormat, "Language", "Files", "Lines", "Code", "Comments", "Blanks", "Complexity", "Tokens", "Complexity/Lines"), "Modified")))
+
+	if !Files {
+		str.WriteString(tableBreak)
+	}
+
+	language := aggregateLanguageSummary(input)
+	sum := aggregateTotal(language)
+
+	var sumWeightedComplexity float64 = 0
+	for _, summary := range language {
+		sumWeightedComplexity += summary.WeightedComplexity
 	}
 
+	color := Files && colorEnabled()
+
 	startTime := makeTimestampMilli()
 	for _, summary := range language {
 		if Files {
-			str.WriteString(tabularWideBreak)
+			str.WriteString(tableBreak)
 		}
 
 		trimmedName := summary.Name
-		if len(summary.Name) > longNameTruncate {
-			trimmedName = summary.Name[:longNameTruncate-1] + "…"
+		if len(summary.Name) > nameTruncate {
+			trimmedName = summary.Name[:nameTruncate-1] + "…"
 		}
 
-		str.WriteString(fmt.Sprintf(tabularWideFormatBody, trimmedName, summary.Count, summary.Lines, summary.Code, summary.Comment, summary.Blank, summary.Complexity, summary.WeightedComplexity))
+		total := percentCount(sum.Code, sum.Lines)
+		row := withModified(fmt.Sprintf(bodyFormat, trimmedName, summary.Count, summary.Lines, summary.Code, summary.Comment, summary.Blank, summary.Complexity, summary.Tokens, summary.WeightedComplexity), "")
+		str.WriteString(withPercent(row, percentCount(summary.Code, summary.Lines), total))
 
 		if Files {
-			sortSummaryFiles(&summary)
-			str.WriteString(tabularWideBreak)
+			str.WriteString(tableBreak)
 
 			for _, res := range summary.Files {
-				tmp := res.Location
-
-				if len(tmp) >= wideFormatFileTrucate {
-					totrim := len(tmp) - wideFormatFileTrucate
-					tmp = "~" + tmp[totrim:]
+				row := withPercent(fmt.Sprintf(fileFormat, truncateLocation(res.Location, width), res.Lines, res.Code, res.Comment, res.Blank, res.Complexity, res.Tokens, res.WeightedComplexity, formatModTime(res.ModTime, "2006-01-02")), percentCount(res.Code, res.Lines), total)
+				if color {
+					row = colorRow(row, complexityColor(res.Complexity))
 				}
 
-				str.WriteString(fmt.Sprintf(tabularWideFormatFile, tmp, res.Lines, res.Code, res.Comment, res.Blank, res.Complexity, res.WeightedComplexity))
+				str.WriteString(row)
 			}
 		}
 	}
@@ -305,156 +1200,132 @@ func fileSummarizeLong(input chan *FileJob) string {
 		printDebug(fmt.Sprintf("milliseconds to build formatted string: %d", makeTimestampMilli()-startTime))
 	}
 
-	str.WriteString(tabularWideBreak)
-	str.WriteString(fmt.Sprintf(tabularWideFormatBody, "Total", sumFiles, sumLines, sumCode, sumComment, sumBlank, sumComplexity, sumWeightedComplexity))
-	str.WriteString(tabularWideBreak)
+	total := percentCount(sum.Code, sum.Lines)
+	str.WriteString(tableBreak)
+	str.WriteString(withPercent(withModified(fmt.Sprintf(bodyFormat, "Total", sum.Files, sum.Lines, sum.Code, sum.Comment, sum.Blank, sum.Complexity, sum.Tokens, sumWeightedComplexity), ""), total, total))
+	str.WriteString(fmt.Sprintf(averageFormat, "Average per File", "", sum.AverageLines, "", "", "", sum.AverageComplexity))
+	str.WriteString(tableBreak)
 
-	if !Cocomo {
-		estimatedEffort := EstimateEffort(int64(sumCode))
-		estimatedCost := EstimateCost(estimatedEffort, AverageWage)
-		estimatedScheduleMonths := EstimateScheduleMonths(estimatedEffort)
-		estimatedPeopleRequired := estimatedEffort / estimatedScheduleMonths
+	if ULOC {
+		ulocSummarize(&str, tabularWideBreak, tabularWideFormatHeadULOC, tabularWideFormatULOC, language)
+	}
 
-		p := gmessage.NewPrinter(glang.English)
+	if Imports {
+		importsSummarize(&str, tabularWideBreak, tabularWideFormatHeadImports, tabularWideFormatImports, language)
+	}
 
-		str.WriteString(p.Sprintf("Estimated Cost to Develop $%d\n", int64(estimatedCost)))
-		str.WriteString(fmt.Sprintf("Estimated Schedule Effort %f months\n", estimatedScheduleMonths))
-		str.WriteString(fmt.Sprintf("Estimated People Required %f\n", estimatedPeopleRequired))
-		str.WriteString(tabularWideBreak)
+	if Tests {
+		testsSummarize(&str, tabularWideBreak, tabularWideFormatHeadTests, tabularWideFormatTests, longNameTruncate, language)
 	}
 
-	return str.String()
-}
+	if Ratios {
+		ratioSummarize(&str, tabularWideBreak, tabularWideFormatHeadRatio, tabularWideFormatRatio, longNameTruncate, language)
+	}
 
-func fileSummarizeShort(input chan *FileJob) string {
-	var str strings.Builder
+	if LineLength {
+		lineLengthSummarize(&str, tabularWideBreak, tabularWideFormatHeadLineLength, tabularWideFormatLineLength, longNameTruncate, language)
+	}
 
-	str.WriteString(tabularShortBreak)
-	if !Complexity {
-		str.WriteString(fmt.Sprintf(tabularShortFormatHead, "Language", "Files", "Lines", "Code", "Comments", "Blanks", "Complexity"))
-	} else {
-		str.WriteString(fmt.Sprintf(tabularShortFormatHeadNoComplexity, "Language", "Files", "Lines", "Code", "Comments", "Blanks"))
+	if ComplexityHistogram {
+		histogramSummarize(&str, tabularWideBreak, tabularWideFormatHeadHistogram, tabularWideFormatHistogram, longNameTruncate, language)
 	}
 
-	if !Files {
-		str.WriteString(tabularShortBreak)
+	if ByDirectory > 0 {
+		directorySummarize(&str, tabularWideBreak, tabularWideFormatHeadDirectory, tabularWideFormatDirectory, 55, true, language)
 	}
 
-	languages := map[string]LanguageSummary{}
-	var sumFiles, sumLines, sumCode, sumComment, sumBlank, sumComplexity int64 = 0, 0, 0, 0, 0, 0
+	if ShowSkipped {
+		skippedSummarize(&str, tabularWideBreak, tabularWideFormatSkipped, wideFormatSkippedTrucate)
+	}
 
-	for res := range input {
-		sumFiles++
-		sumLines += res.Lines
-		sumCode += res.Code
-		sumComment += res.Comment
-		sumBlank += res.Blank
-		sumComplexity += res.Complexity
+	if DuplicateGroups {
+		duplicatesSummarize(&str, tabularWideBreak, tabularWideFormatSkipped, wideFormatSkippedTrucate)
+	}
 
-		_, ok := languages[res.Language]
+	if !NoCocomo {
+		calculateCocomo(weightedCode(language), &str)
+		str.WriteString(tabularWideBreak)
+	}
 
-		if !ok {
-			files := []*FileJob{}
-			files = append(files, res)
+	return str.String()
+}
 
-			languages[res.Language] = LanguageSummary{
-				Name:       res.Language,
-				Lines:      res.Lines,
-				Code:       res.Code,
-				Comment:    res.Comment,
-				Blank:      res.Blank,
-				Complexity: res.Complexity,
-				Count:      1,
-				Files:      files,
-			}
-		} else {
-			tmp := languages[res.Language]
-			files := append(tmp.Files, res)
-
-			languages[res.Language] = LanguageSummary{
-				Name:       res.Language,
-				Lines:      tmp.Lines + res.Lines,
-				Code:       tmp.Code + res.Code,
-				Comment:    tmp.Comment + res.Comment,
-				Blank:      tmp.Blank + res.Blank,
-				Complexity: tmp.Complexity + res.Complexity,
-				Count:      tmp.Count + 1,
-				Files:      files,
-			}
+func fileSummarizeShort(input chan *FileJob) string {
+	var str strings.Builder
+
+	headFormat, bodyFormat, fileFormat, averageFormat, fileTruncate := tabularShortFormatHead, tabularShortFormatBody, tabularShortFormatFile, tabularShortFormatAverage, shortFormatFileTrucate
+	if Complexity {
+		headFormat, bodyFormat, fileFormat, averageFormat, fileTruncate = tabularShortFormatHeadNoComplexity, tabularShortFormatBodyNoComplexity, tabularShortFormatFileNoComplexity, tabularShortFormatAverageNoComplexity, shortFormatFileTrucateNoComplexity
+	}
+
+	nameTruncate := shortNameTruncate
+	if Percent {
+		nameTruncate = shortNameTruncatePercent
+		headFormat, bodyFormat, fileFormat, averageFormat, fileTruncate = tabularShortFormatHeadPercent, tabularShortFormatBodyPercent, tabularShortFormatFilePercent, tabularShortFormatAveragePercent, shortFormatFileTrucatePercent
+		if Complexity {
+			headFormat, bodyFormat, fileFormat, averageFormat, fileTruncate = tabularShortFormatHeadNoComplexityPercent, tabularShortFormatBodyNoComplexityPercent, tabularShortFormatFileNoComplexityPercent, tabularShortFormatAverageNoComplexityPercent, shortFormatFileTrucateNoComplexityPercent
 		}
 	}
 
-	language := []LanguageSummary{}
-	for _, summary := range languages {
-		language = append(language, summary)
+	// The rest of the table is resized along with the rows of files so that they line up
+	tableBreak, width := tabularShortBreak, fileTruncate+1
+	if Files {
+		fileFormat, width = fileColumn(fileFormat, fileTruncate, tabularShortBreak, headFormat)
+		columns := width - fileTruncate - 1
+		nameTruncate += columnWidth(resizeColumn(headFormat, columns)) - columnWidth(headFormat)
+		tableBreak, headFormat, bodyFormat, averageFormat = resizeBreak(tabularShortBreak, columns), resizeColumn(headFormat, columns), resizeColumn(bodyFormat, columns), resizeColumn(averageFormat, columns)
 	}
 
-	// Cater for the common case of adding plural even for those options that don't make sense
-	// as its quite common for those who English is not a first language to make a simple mistake
-	switch {
-	case SortBy == "name" || SortBy == "names" || SortBy == "language" || SortBy == "languages":
-		sort.Slice(language, func(i, j int) bool {
-			return strings.Compare(language[i].Name, language[j].Name) < 0
-		})
-	case SortBy == "line" || SortBy == "lines":
-		sort.Slice(language, func(i, j int) bool {
-			return language[i].Lines > language[j].Lines
-		})
-	case SortBy == "blank" || SortBy == "blanks":
-		sort.Slice(language, func(i, j int) bool {
-			return language[i].Blank > language[j].Blank
-		})
-	case SortBy == "code" || SortBy == "codes":
-		sort.Slice(language, func(i, j int) bool {
-			return language[i].Code > language[j].Code
-		})
-	case SortBy == "comment" || SortBy == "comments":
-		sort.Slice(language, func(i, j int) bool {
-			return language[i].Comment > language[j].Comment
-		})
-	case SortBy == "complexity" || SortBy == "complexitys":
-		sort.Slice(language, func(i, j int) bool {
-			return language[i].Complexity > language[j].Complexity
-		})
-	default:
-		sort.Slice(language, func(i, j int) bool {
-			return language[i].Count > language[j].Count
-		})
+	str.WriteString(tableBreak)
+	if !Complexity {
+		str.WriteString(withPercentHead(fmt.Sprintf(headFormat, "Language", "Files", "Lines", "Code", "Comments", "Blanks", "Complexity")))
+	} else {
+		str.WriteString(withPercentHead(fmt.Sprintf(headFormat, "Language", "Files", "Lines", "Code", "Comments", "Blanks")))
+	}
+
+	if !Files {
+		str.WriteString(tableBreak)
 	}
 
+	language := aggregateLanguageSummary(input)
+	sum := aggregateTotal(language)
+
+	color := Files && colorEnabled()
+
 	startTime := makeTimestampMilli()
 	for _, summary := range language {
 		if Files {
-			str.WriteString(tabularShortBreak)
+			str.WriteString(tableBreak)
 		}
 
 		trimmedName := summary.Name
-		if len(summary.Name) > shortNameTruncate {
-			trimmedName = summary.Name[:shortNameTruncate-1] + "…"
+		if len(summary.Name) > nameTruncate {
+			trimmedName = summary.Name[:nameTruncate-1] + "…"
 		}
 
+		count, total := percentCount(summary.Code, summary.Lines), percentCount(sum.Code, sum.Lines)
 		if !Complexity {
-			str.WriteString(fmt.Sprintf(tabularShortFormatBody, trimmedName, summary.Count, summary.Lines, summary.Code, summary.Comment, summary.Blank, summary.Complexity))
+			str.WriteString(withPercent(fmt.Sprintf(bodyFormat, trimmedName, summary.Count, summary.Lines, summary.Code, summary.Comment, summary.Blank, summary.Complexity), count, total))
 		} else {
-			str.WriteString(fmt.Sprintf(tabularShortFormatBodyNoComplexity, trimmedName, summary.Count, summary.Lines, summary.Code, summary.Comment, summary.Blank))
+			str.WriteString(withPercent(fmt.Sprintf(bodyFormat, trimmedName, summary.Count, summary.Lines, summary.Code, summary.Comment, summary.Blank), count, total))
 		}
 
 		if Files {
-			sortSummaryFiles(&summary)
-			str.WriteString(tabularShortBreak)
+			str.WriteString(tableBreak)
 
 			for _, res := range summary.Files {
-				tmp := res.Location
-
-				if len(tmp) >= shortFormatFileTrucate {
-					totrim := len(tmp) - shortFormatFileTrucate
-					tmp = "~" + tmp[totrim:]
-				}
+				tmp := truncateLocation(res.Location, width)
+				count := percentCount(res.Code, res.Lines)
 
 				if !Complexity {
-					str.WriteString(fmt.Sprintf(tabularShortFormatFile, tmp, res.Lines, res.Code, res.Comment, res.Blank, res.Complexity))
+					row := withPercent(fmt.Sprintf(fileFormat, tmp, res.Lines, res.Code, res.Comment, res.Blank, res.Complexity), count, total)
+					if color {
+						row = colorRow(row, complexityColor(res.Complexity))
+					}
+
+					str.WriteString(row)
 				} else {
-					str.WriteString(fmt.Sprintf(tabularShortFormatFileNoComplexity, tmp, res.Lines, res.Code, res.Comment, res.Blank))
+					str.WriteString(withPercent(fmt.Sprintf(fileFormat, tmp, res.Lines, res.Code, res.Comment, res.Blank), count, total))
 				}
 			}
 		}
@@ -464,36 +1335,299 @@ func fileSummarizeShort(input chan *FileJob) string {
 		printDebug(fmt.Sprintf("milliseconds to build formatted string: %d", makeTimestampMilli()-startTime))
 	}
 
-	str.WriteString(tabularShortBreak)
+	total := percentCount(sum.Code, sum.Lines)
+	str.WriteString(tableBreak)
 	if !Complexity {
-		str.WriteString(fmt.Sprintf(tabularShortFormatBody, "Total", sumFiles, sumLines, sumCode, sumComment, sumBlank, sumComplexity))
+		str.WriteString(withPercent(fmt.Sprintf(bodyFormat, "Total", sum.Files, sum.Lines, sum.Code, sum.Comment, sum.Blank, sum.Complexity), total, total))
+		str.WriteString(fmt.Sprintf(averageFormat, "Average per File", "", sum.AverageLines, "", "", "", sum.AverageComplexity))
 	} else {
-		str.WriteString(fmt.Sprintf(tabularShortFormatBodyNoComplexity, "Total", sumFiles, sumLines, sumCode, sumComment, sumBlank))
+		str.WriteString(withPercent(fmt.Sprintf(bodyFormat, "Total", sum.Files, sum.Lines, sum.Code, sum.Comment, sum.Blank), total, total))
+		str.WriteString(fmt.Sprintf(averageFormat, "Average per File", "", sum.AverageLines))
+	}
+	str.WriteString(tableBreak)
+
+	if ULOC {
+		ulocSummarize(&str, tabularShortBreak, tabularShortFormatHeadULOC, tabularShortFormatULOC, language)
+	}
+
+	if Imports {
+		importsSummarize(&str, tabularShortBreak, tabularShortFormatHeadImports, tabularShortFormatImports, language)
+	}
+
+	if Tests {
+		testsSummarize(&str, tabularShortBreak, tabularShortFormatHeadTests, tabularShortFormatTests, shortNameTruncate, language)
+	}
+
+	if Ratios {
+		ratioSummarize(&str, tabularShortBreak, tabularShortFormatHeadRatio, tabularShortFormatRatio, shortNameTruncate, language)
+	}
+
+	if ComplexityHistogram {
+		histogramSummarize(&str, tabularShortBreak, tabularShortFormatHeadHistogram, tabularShortFormatHistogram, shortNameTruncate, language)
+	}
+
+	if ByDirectory > 0 {
+		if !Complexity {
+			directorySummarize(&str, tabularShortBreak, tabularShortFormatHeadDirectory, tabularShortFormatDirectory, 25, true, language)
+		} else {
+			directorySummarize(&str, tabularShortBreak, tabularShortFormatHeadDirectoryNoComplexity, tabularShortFormatDirectoryNoComplexity, 36, false, language)
+		}
 	}
-	str.WriteString(tabularShortBreak)
 
-	if !Cocomo {
-		estimatedEffort := EstimateEffort(int64(sumCode))
-		estimatedCost := EstimateCost(estimatedEffort, AverageWage)
-		estimatedScheduleMonths := EstimateScheduleMonths(estimatedEffort)
-		estimatedPeopleRequired := estimatedEffort / estimatedScheduleMonths
+	if ShowSkipped {
+		skippedSummarize(&str, tabularShortBreak, tabularShortFormatSkipped, shortFormatSkippedTrucate)
+	}
 
-		p := gmessage.NewPrinter(glang.English)
+	if DuplicateGroups {
+		duplicatesSummarize(&str, tabularShortBreak, tabularShortFormatSkipped, shortFormatSkippedTrucate)
+	}
 
-		str.WriteString(p.Sprintf("Estimated Cost to Develop $%d\n", int64(estimatedCost)))
-		str.WriteString(fmt.Sprintf("Estimated Schedule Effort %f months\n", estimatedScheduleMonths))
-		str.WriteString(fmt.Sprintf("Estimated People Required %f\n", estimatedPeopleRequired))
+	if !NoCocomo {
+		calculateCocomo(weightedCode(language), &str)
 		str.WriteString(tabularShortBreak)
 	}
 
 	return str.String()
 }
 
+// Writes each group of files with the same content where the files in a group
+// share a number so all but one of them can be removed
+func duplicatesSummarize(str *strings.Builder, tabularBreak string, format string, truncate int) {
+	groups := duplicates.Groups()
+
+	str.WriteString(fmt.Sprintf(format, fmt.Sprintf("Duplicate Files (%d groups)", len(groups)), "Group"))
+	str.WriteString(tabularBreak)
+
+	for i, group := range groups {
+		for _, location := range group {
+			str.WriteString(fmt.Sprintf(format, truncateLocation(location, truncate+1), strconv.Itoa(i+1)))
+		}
+	}
+
+	if len(groups) != 0 {
+		str.WriteString(tabularBreak)
+	}
+}
+
+// Counts how many of the files fall into each of the complexityBuckets
+func complexityHistogram(files []*FileJob) []int64 {
+	histogram := make([]int64, len(complexityBuckets))
+
+	for _, file := range files {
+		switch {
+		case file.Complexity <= 0:
+			histogram[0]++
+		case file.Complexity <= 5:
+			histogram[1]++
+		case file.Complexity <= 20:
+			histogram[2]++
+		default:
+			histogram[3]++
+		}
+	}
+
+	return histogram
+}
+
+// Writes how many files of each language fall into each of the complexityBuckets
+// to show if the complexity is spread out or concentrated in a few files
+func histogramSummarize(str *strings.Builder, tabularBreak string, headFormat string, format string, truncate int, language []LanguageSummary) {
+	str.WriteString(fmt.Sprintf(headFormat, "Files by Complexity", complexityBuckets[0], complexityBuckets[1], complexityBuckets[2], complexityBuckets[3]))
+	str.WriteString(tabularBreak)
+
+	for _, summary := range language {
+		trimmedName := summary.Name
+		if len(summary.Name) > truncate {
+			trimmedName = summary.Name[:truncate-1] + "…"
+		}
+
+		h := complexityHistogram(summary.Files)
+		str.WriteString(fmt.Sprintf(format, trimmedName, h[0], h[1], h[2], h[3]))
+	}
+
+	str.WriteString(tabularBreak)
+}
+
+// Writes the code of each language which has test files next to the code of its tests and the
+// test code as a percentage of the code, followed by the same for every language together
+func testsSummarize(str *strings.Builder, tabularBreak string, headFormat string, format string, truncate int, language []LanguageSummary) {
+	code := map[string]int64{}
+	for _, summary := range language {
+		code[summary.Name] = summary.Code
+	}
+
+	str.WriteString(fmt.Sprintf(headFormat, "Tests", "Code", "Test Code", "Tests/Code"))
+	str.WriteString(tabularBreak)
+
+	var sumCode, sumTests int64
+	for _, summary := range language {
+		if !strings.HasSuffix(summary.Name, TestsLanguageSuffix) {
+			sumCode += summary.Code
+			continue
+		}
+		sumTests += summary.Code
+
+		name := strings.TrimSuffix(summary.Name, TestsLanguageSuffix)
+		trimmedName := name
+		if len(name) > truncate {
+			trimmedName = name[:truncate-1] + "…"
+		}
+
+		str.WriteString(fmt.Sprintf(format, trimmedName, code[name], summary.Code, percentage(summary.Code, code[name])))
+	}
+
+	str.WriteString(tabularBreak)
+	str.WriteString(fmt.Sprintf(format, "Total", sumCode, sumTests, percentage(sumTests, sumCode)))
+	str.WriteString(tabularBreak)
+}
+
+// The share of the lines of the language which are blank, comment and code as percentages
+func ratios(summary LanguageSummary) (float64, float64, float64) {
+	return percentage(summary.Blank, summary.Lines), percentage(summary.Comment, summary.Lines), percentage(summary.Code, summary.Lines)
+}
+
+// Writes the share of the lines of each language which are blank, comment and code
+// as a rough measure of how well documented the code of each language is
+func ratioSummarize(str *strings.Builder, tabularBreak string, headFormat string, format string, truncate int, language []LanguageSummary) {
+	str.WriteString(fmt.Sprintf(headFormat, "Share of Lines", "Blanks", "Comments", "Code"))
+	str.WriteString(tabularBreak)
+
+	for _, summary := range language {
+		trimmedName := summary.Name
+		if len(summary.Name) > truncate {
+			trimmedName = summary.Name[:truncate-1] + "…"
+		}
+
+		blank, comment, code := ratios(summary)
+		str.WriteString(fmt.Sprintf(format, trimmedName, blank, comment, code))
+	}
+
+	str.WriteString(tabularBreak)
+}
+
+// Writes the average and longest line length in characters of each language followed by
+// the total, where a long maximum points to files with overlong lines
+func lineLengthSummarize(str *strings.Builder, tabularBreak string, headFormat string, format string, truncate int, language []LanguageSummary) {
+	str.WriteString(fmt.Sprintf(headFormat, "Line Length", "Average", "Max"))
+	str.WriteString(tabularBreak)
+
+	var sumLength, sumLines, maxLength int64
+	for _, summary := range language {
+		trimmedName := summary.Name
+		if len(summary.Name) > truncate {
+			trimmedName = summary.Name[:truncate-1] + "…"
+		}
+
+		sumLength += summary.lineLengthSum
+		sumLines += summary.lineLengthLines
+		if summary.MaxLineLength > maxLength {
+			maxLength = summary.MaxLineLength
+		}
+
+		str.WriteString(fmt.Sprintf(format, trimmedName, summary.AverageLineLength, summary.MaxLineLength))
+	}
+
+	str.WriteString(tabularBreak)
+	str.WriteString(fmt.Sprintf(format, "Total", average(sumLength, sumLines), maxLength))
+	str.WriteString(tabularBreak)
+}
+
+// Writes the unique lines of code for each language followed by the total
+// where lines repeated in different languages are only counted once
+func ulocSummarize(str *strings.Builder, tabularBreak string, headFormat string, format string, language []LanguageSummary) {
+	str.WriteString(fmt.Sprintf(headFormat, "Language", "ULOC"))
+	str.WriteString(tabularBreak)
+
+	for _, summary := range language {
+		str.WriteString(fmt.Sprintf(format, summary.Name, uniqueLines.Language(summary.Name)))
+	}
+
+	str.WriteString(tabularBreak)
+	str.WriteString(fmt.Sprintf(format, "Total", uniqueLines.Total()))
+	str.WriteString(tabularBreak)
+}
+
+// Writes the import lines of each language followed by the total. These
+// are not included in the code of the summary when using --imports
+func importsSummarize(str *strings.Builder, tabularBreak string, headFormat string, format string, language []LanguageSummary) {
+	str.WriteString(fmt.Sprintf(headFormat, "Language", "Imports"))
+	str.WriteString(tabularBreak)
+
+	var sumImports int64
+	for _, summary := range language {
+		sumImports += summary.Imports
+		str.WriteString(fmt.Sprintf(format, summary.Name, summary.Imports))
+	}
+
+	str.WriteString(tabularBreak)
+	str.WriteString(fmt.Sprintf(format, "Total", sumImports))
+	str.WriteString(tabularBreak)
+}
+
+// Writes out each file which was found but skipped along with the reason why
+func skippedSummarize(str *strings.Builder, tabularBreak string, format string, truncate int) {
+	files := skipped.Files()
+
+	str.WriteString(fmt.Sprintf(format, fmt.Sprintf("Skipped Files (%d)", len(files)), "Reason"))
+	str.WriteString(tabularBreak)
+
+	for _, file := range files {
+		str.WriteString(fmt.Sprintf(format, truncateLocation(file.Location, truncate+1), file.Reason))
+	}
+
+	if len(files) != 0 {
+		str.WriteString(tabularBreak)
+	}
+}
+
+// Writes the COCOMO estimates for the supplied lines of code which callers
+// should only do when NoCocomo is not set
+func calculateCocomo(sumCode int64, str *strings.Builder) {
+	estimatedEffort := EstimateEffort(int64(sumCode))
+	estimatedCost := EstimateCost(estimatedEffort, AverageWage)
+	estimatedScheduleMonths := EstimateScheduleMonths(estimatedEffort)
+	estimatedPeopleRequired := estimatedEffort / estimatedScheduleMonths
+
+	str.WriteString(fmt.Sprintf("Estimated Cost to Develop %s%s\n", CurrencySymbol, formatThousands(int64(estimatedCost), ThousandsSeparator)))
+	str.WriteString(fmt.Sprintf("Estimated Schedule Effort %f months\n", estimatedScheduleMonths))
+	str.WriteString(fmt.Sprintf("Estimated People Required %f\n", estimatedPeopleRequired))
+}
+
+// Formats the value with the separator between each group of thousands
+// such as 1,234,567 or 1.234.567 with no grouping if the separator is empty
+func formatThousands(value int64, separator string) string {
+	digits := strconv.FormatInt(value, 10)
+	sign := ""
+	if value < 0 {
+		sign, digits = "-", digits[1:]
+	}
+
+	if separator == "" || len(digits) <= 3 {
+		return sign + digits
+	}
+
+	var str strings.Builder
+	str.WriteString(sign)
+	for i, digit := range digits {
+		if i != 0 && (len(digits)-i)%3 == 0 {
+			str.WriteString(separator)
+		}
+		str.WriteRune(digit)
+	}
+
+	return str.String()
+}
+
 // Get the time as standard UTC/Zulu format
 func getFormattedTime() string {
 	return time.Now().UTC().Format(time.RFC3339)
 }
 
+// Prints an error message to stderr regardless of any flags
+func printError(msg string) {
+	fmt.Fprintln(os.Stderr, fmt.Sprintf("ERROR %s: %s", getFormattedTime(), msg))
+}
+
 // Prints a message to stdout if flag to enable warning output is set
 func printWarn(msg string) {
 	if Verbose {
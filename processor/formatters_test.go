@@ -1,9 +1,106 @@
 package processor
 
 import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
 	"testing"
+	"text/template"
+	"time"
+	"unicode/utf8"
 )
 
+func TestToJsonTotals(t *testing.T) {
+	Files = false
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Lines: 10, Code: 6, Comment: 2, Blank: 2, Complexity: 3}
+	inputChan <- &FileJob{Language: "Go", Lines: 5, Code: 5, Complexity: 1}
+	inputChan <- &FileJob{Language: "Java", Lines: 1, Comment: 1}
+	close(inputChan)
+
+	var res jsonSummary
+	if err := json.Unmarshal([]byte(toJson(inputChan)), &res); err != nil {
+		t.Fatalf("Expected valid JSON got %s", err)
+	}
+
+	if res.SchemaVersion != JsonSchemaVersion {
+		t.Errorf("Expected schema version %d got %d", JsonSchemaVersion, res.SchemaVersion)
+	}
+
+	if len(res.Languages) != 2 {
+		t.Errorf("Expected 2 languages got %d", len(res.Languages))
+	}
+
+	if res.Total.Files != 3 || res.Total.Lines != 16 || res.Total.Code != 11 || res.Total.Comment != 3 || res.Total.Blank != 2 || res.Total.Complexity != 4 {
+		t.Errorf("Unexpected totals %+v", res.Total)
+	}
+
+	if res.Total.AverageLines != 16.0/3 || res.Total.AverageComplexity != 4.0/3 {
+		t.Errorf("Unexpected total averages %+v", res.Total)
+	}
+
+	for _, l := range res.Languages {
+		if l.Name == "Go" && (l.AverageLines != 7.5 || l.AverageComplexity != 2) {
+			t.Errorf("Unexpected averages for Go %+v", l)
+		}
+	}
+
+	for _, l := range res.Languages {
+		if len(l.Files) != 0 {
+			t.Errorf("Expected no files without Files set got %d", len(l.Files))
+		}
+	}
+}
+
+func TestToJsonFiles(t *testing.T) {
+	Files = true
+	defer func() { Files = false }()
+
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "main.go", Filename: "main.go", Lines: 10, Content: []byte("content")}
+	close(inputChan)
+
+	output := toJson(inputChan)
+	var res jsonSummary
+	if err := json.Unmarshal([]byte(output), &res); err != nil {
+		t.Fatalf("Expected valid JSON got %s", err)
+	}
+
+	if len(res.Languages) != 1 || len(res.Languages[0].Files) != 1 {
+		t.Fatalf("Expected a single file in output")
+	}
+
+	if res.Languages[0].Files[0].Location != "main.go" || res.Languages[0].Files[0].Lines != 10 {
+		t.Errorf("Unexpected file %+v", res.Languages[0].Files[0])
+	}
+
+	// The content and callback are never written, which decoding cannot show as they are not read either
+	if strings.Contains(output, `"Content"`) || strings.Contains(output, `"Callback"`) {
+		t.Errorf("Expected no content or callback in output got %s", output)
+	}
+}
+
+func TestToJsonFilesModTime(t *testing.T) {
+	Files = true
+	defer func() { Files = false }()
+
+	modTime := time.Date(2018, 3, 9, 10, 30, 0, 0, time.UTC)
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "main.go", ModTime: &modTime}
+	inputChan <- &FileJob{Language: "Go", Location: "git.go"}
+	close(inputChan)
+
+	// Files such as those counted from --git-ref have no modification time so it is left out
+	got := toJson(inputChan)
+	if strings.Count(got, `"ModTime"`) != 1 || !strings.Contains(got, `"ModTime":"2018-03-09T10:30:00Z"`) {
+		t.Errorf("Expected only the known modification time got %s", got)
+	}
+}
+
 // When using columise  ~28726 ns/op
 // When using optimised ~14293 ns/op
 func BenchmarkFileSummerize(b *testing.B) {
@@ -26,3 +123,1085 @@ func BenchmarkFileSummerize(b *testing.B) {
 		fileSummarize(fileSummaryJobQueue)
 	}
 }
+
+func TestToCSVLanguages(t *testing.T) {
+	Files = false
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "main.go", Lines: 10, Code: 6, Comment: 2, Blank: 2, Complexity: 3}
+	inputChan <- &FileJob{Language: "Go", Location: "other.go", Lines: 5, Code: 5, Complexity: 1}
+	close(inputChan)
+
+	got := toCSV(inputChan)
+	expected := "Language,Files,Lines,Blanks,Comments,Code,Complexity,Average Lines,Average Complexity\nGo,2,15,2,2,11,4,7.50,2.00\n"
+
+	if got != expected {
+		t.Errorf("Expected %s got %s", expected, got)
+	}
+}
+
+func TestToCSVFilesQuoted(t *testing.T) {
+	Files = true
+	defer func() { Files = false }()
+
+	modTime := time.Date(2018, 3, 9, 10, 30, 0, 0, time.UTC)
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "some,file.go", Lines: 1, Code: 1, ModTime: &modTime}
+	inputChan <- &FileJob{Language: "Go", Location: "unknown.go", Lines: 1, Code: 1}
+	close(inputChan)
+
+	got := toCSV(inputChan)
+
+	if !strings.Contains(got, "\nFilename,Language,Lines,Code,Comments,Blanks,Complexity,Modified\n") {
+		t.Errorf("Expected file header got %s", got)
+	}
+
+	if !strings.Contains(got, "\"some,file.go\",Go,1,1,0,0,0,2018-03-09T10:30:00Z\n") {
+		t.Errorf("Expected quoted filename got %s", got)
+	}
+
+	if !strings.Contains(got, "unknown.go,Go,1,1,0,0,0,\n") {
+		t.Errorf("Expected empty modification time got %s", got)
+	}
+}
+
+func TestToOpenMetrics(t *testing.T) {
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "main.go", Lines: 10, Code: 6, Comment: 2, Blank: 2, Complexity: 3}
+	inputChan <- &FileJob{Language: `C "quoted" \ name`, Location: "main.c", Lines: 5, Code: 5, Complexity: 1}
+	close(inputChan)
+
+	got := toOpenMetrics(inputChan)
+
+	for _, line := range []string{
+		"# TYPE scc_code_lines gauge\n",
+		"scc_code_lines{language=\"Go\"} 6\n",
+		"scc_code_lines{language=\"C \\\"quoted\\\" \\\\ name\"} 5\n",
+		"scc_code_lines 11\n",
+		"scc_files 2\n",
+	} {
+		if !strings.Contains(got, line) {
+			t.Errorf("Expected %q in output got %s", line, got)
+		}
+	}
+
+	if !strings.HasSuffix(got, "# EOF\n") {
+		t.Errorf("Expected output to end with # EOF got %s", got)
+	}
+}
+
+func TestOpenMetricsLabel(t *testing.T) {
+	if got := openMetricsLabel("a\"b\\c\nd"); got != `a\"b\\c\nd` {
+		t.Errorf("Expected %s got %s", `a\"b\\c\nd`, got)
+	}
+}
+
+func TestNoCocomoAllFormats(t *testing.T) {
+	NoCocomo = true
+	defer func() { NoCocomo = false }()
+
+	for _, format := range []string{"tabular", "wide", "json", "csv"} {
+		Format = format
+		inputChan := make(chan *FileJob, 10)
+		inputChan <- &FileJob{Language: "Go", Lines: 1000, Code: 1000}
+		close(inputChan)
+
+		if got := summarizeOutput(inputChan); strings.Contains(got, "Estimated") {
+			t.Errorf("Expected no COCOMO output for %s got %s", format, got)
+		}
+	}
+	Format = ""
+}
+
+func TestCocomoShown(t *testing.T) {
+	for _, format := range []string{"tabular", "wide"} {
+		Format = format
+		inputChan := make(chan *FileJob, 10)
+		inputChan <- &FileJob{Language: "Go", Lines: 1000, Code: 1000}
+		close(inputChan)
+
+		if got := summarizeOutput(inputChan); !strings.Contains(got, "Estimated Cost to Develop") {
+			t.Errorf("Expected COCOMO output for %s got %s", format, got)
+		}
+	}
+	Format = ""
+}
+
+func TestFormatThousands(t *testing.T) {
+	cases := []struct {
+		value     int64
+		separator string
+		expected  string
+	}{
+		{0, ",", "0"},
+		{999, ",", "999"},
+		{1000, ",", "1,000"},
+		{5513136, ",", "5,513,136"},
+		{5513136, ".", "5.513.136"},
+		{5513136, "", "5513136"},
+		{-1234567, ",", "-1,234,567"},
+	}
+
+	for _, c := range cases {
+		if got := formatThousands(c.value, c.separator); got != c.expected {
+			t.Errorf("Expected %s got %s", c.expected, got)
+		}
+	}
+}
+
+func TestCocomoCurrencySymbol(t *testing.T) {
+	CurrencySymbol = "€"
+	ThousandsSeparator = "."
+	defer func() {
+		CurrencySymbol = "$"
+		ThousandsSeparator = ","
+	}()
+
+	var str strings.Builder
+	calculateCocomo(100000, &str)
+
+	if !strings.Contains(str.String(), "Estimated Cost to Develop €") || !strings.Contains(str.String(), ".") {
+		t.Errorf("Expected euro cost got %s", str.String())
+	}
+}
+
+func TestToSQL(t *testing.T) {
+	Files = true
+	SQLTable = `my"table`
+	defer func() {
+		Files = false
+		SQLTable = "t"
+	}()
+
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "bob's.go", Lines: 10, Code: 6, Comment: 2, Blank: 2, Complexity: 3}
+	close(inputChan)
+
+	got := toSQL(inputChan)
+
+	if !strings.HasPrefix(got, `create table if not exists "my""table" (`) {
+		t.Errorf("Expected escaped table name got %s", got)
+	}
+
+	if !strings.Contains(got, "'Go', null, 1, 10, 6, 2, 2, 3);") {
+		t.Errorf("Expected language row got %s", got)
+	}
+
+	if !strings.Contains(got, "'Go', 'bob''s.go', 1, 10, 6, 2, 2, 3);") {
+		t.Errorf("Expected escaped file row got %s", got)
+	}
+}
+
+func TestToNdjson(t *testing.T) {
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "main.go", Lines: 10, Code: 6, Comment: 2, Blank: 2, Complexity: 3}
+	inputChan <- &FileJob{Language: "Java", Location: "Main.java", Lines: 5, Code: 5}
+	close(inputChan)
+
+	var str strings.Builder
+	toNdjson(inputChan, &str)
+	lines := strings.Split(strings.TrimSpace(str.String()), "\n")
+
+	if len(lines) != 3 {
+		t.Fatalf("Expected 3 lines got %d", len(lines))
+	}
+
+	var file ndjsonFile
+	if err := json.Unmarshal([]byte(lines[0]), &file); err != nil || file.Type != "file" || file.Location != "main.go" {
+		t.Errorf("Expected file record got %s", lines[0])
+	}
+
+	var total ndjsonTotal
+	if err := json.Unmarshal([]byte(lines[2]), &total); err != nil || total.Type != "total" || total.Files != 2 || total.Lines != 15 {
+		t.Errorf("Expected total record got %s", lines[2])
+	}
+}
+
+func TestFilesOnly(t *testing.T) {
+	FilesOnly = true
+	defer func() { FilesOnly = false }()
+
+	newInput := func() chan *FileJob {
+		inputChan := make(chan *FileJob, 10)
+		inputChan <- &FileJob{Language: "Go", Location: "main.go", Lines: 10, Code: 6, Comment: 2, Blank: 2, Complexity: 3}
+		inputChan <- &FileJob{Language: "Java", Location: "Main.java", Lines: 5, Code: 5}
+		close(inputChan)
+		return inputChan
+	}
+
+	var str strings.Builder
+	toJsonFiles(newInput(), &str)
+
+	var files []FileJob
+	if err := json.Unmarshal([]byte(str.String()), &files); err != nil {
+		t.Fatalf("Expected a JSON array got %s %s", err, str.String())
+	}
+
+	if len(files) != 2 || files[0].Location != "main.go" || files[0].Code != 6 || files[1].Language != "Java" {
+		t.Errorf("Expected the files got %+v", files)
+	}
+
+	empty := make(chan *FileJob)
+	close(empty)
+	str.Reset()
+	toJsonFiles(empty, &str)
+	if err := json.Unmarshal([]byte(str.String()), &files); err != nil || len(files) != 0 {
+		t.Errorf("Expected an empty JSON array got %s", str.String())
+	}
+
+	str.Reset()
+	toNdjson(newInput(), &str)
+	if lines := strings.Split(strings.TrimSpace(str.String()), "\n"); len(lines) != 2 {
+		t.Errorf("Expected only the 2 files got %s", str.String())
+	}
+}
+
+func TestToWc(t *testing.T) {
+	Files = true
+	defer func() { Files = false }()
+
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "b.go", Lines: 95}
+	inputChan <- &FileJob{Language: "Java", Location: "a.java", Lines: 5}
+	close(inputChan)
+
+	got := toWc(inputChan)
+	expected := "  5 a.java\n 95 b.go\n100 total\n"
+
+	if got != expected {
+		t.Errorf("Expected %q got %q", expected, got)
+	}
+}
+
+func TestToWcTotalOnly(t *testing.T) {
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "b.go", Lines: 95}
+	close(inputChan)
+
+	if got := toWc(inputChan); got != "95 total\n" {
+		t.Errorf("Expected total only got %q", got)
+	}
+}
+
+func TestLinesSummarize(t *testing.T) {
+	LinesOnly = true
+	Files = true
+	defer func() {
+		LinesOnly = false
+		Files = false
+	}()
+
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "b.go", Lines: 95}
+	inputChan <- &FileJob{Language: "Java", Location: "a.java", Lines: 5}
+	close(inputChan)
+
+	got := summarizeOutput(inputChan)
+
+	for _, line := range []string{
+		fmt.Sprintf(tabularShortFormatHeadLines, "Language", "Files", "Lines"),
+		fmt.Sprintf(tabularShortFormatLines, "Go", 1, 95),
+		fmt.Sprintf(tabularShortFormatFileLines, "a.java", 5),
+		fmt.Sprintf(tabularShortFormatLines, "Total", 2, 100),
+	} {
+		if !strings.Contains(got, line) {
+			t.Errorf("Expected %q in %s", line, got)
+		}
+	}
+
+	if strings.Contains(got, "Code") {
+		t.Errorf("Expected only lines got %s", got)
+	}
+}
+
+func TestFileSummarizeShortAverage(t *testing.T) {
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "a.go", Lines: 10, Code: 10, Complexity: 2}
+	inputChan <- &FileJob{Language: "Java", Location: "b.java", Lines: 5, Code: 5, Complexity: 1}
+	close(inputChan)
+
+	got := fileSummarizeShort(inputChan)
+
+	expected := fmt.Sprintf(tabularShortFormatAverage, "Average per File", "", 7.5, "", "", "", 1.5)
+	if !strings.Contains(got, expected) {
+		t.Errorf("Expected %q in %s", expected, got)
+	}
+
+	if average(10, 0) != 0 {
+		t.Error("Expected an average of no files to be 0")
+	}
+}
+
+func TestTruncateLocation(t *testing.T) {
+	cases := []struct {
+		location string
+		width    int
+		expected string
+	}{
+		{"main.go", 30, "main.go"},
+		{"some/very/deep/path/to/the/file.go", 34, "some/very/deep/path/to/the/file.go"},
+		{"some/very/deep/path/to/the/file.go", 20, "~/to/the/file.go"},
+		{"some/very/deep/path/to/the/file.go", 10, "~/file.go"},
+		{"some/a_very_long_file_name.go", 10, "~e_name.go"},
+	}
+
+	for _, c := range cases {
+		if got := truncateLocation(c.location, c.width); got != c.expected {
+			t.Errorf("Expected %s got %s", c.expected, got)
+		}
+
+		if got := truncateLocation(c.location, c.width); len(got) > c.width {
+			t.Errorf("Expected %s to fit in %d", got, c.width)
+		}
+	}
+}
+
+func TestFileSummarizeFilenameWidth(t *testing.T) {
+	Files = true
+	FilenameWidth = 12
+	defer func() {
+		Files = false
+		FilenameWidth = 0
+	}()
+
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "some/deep/path/main.go", Lines: 10, Code: 8, Comment: 1, Blank: 1, Complexity: 2}
+	close(inputChan)
+
+	got := fileSummarizeShort(inputChan)
+
+	expected := fmt.Sprintf("%-12s %9d %8d %9d %8d %10d\n", "~/main.go", 10, 8, 1, 1, 2)
+	if !strings.Contains(got, expected) {
+		t.Errorf("Expected %q in %s", expected, got)
+	}
+}
+
+func TestFileColumnTerminalWidth(t *testing.T) {
+	columns := 0
+	original := outputColumns
+	outputColumns = func() int { return columns }
+	defer func() {
+		outputColumns = original
+		FilenameWidth = 0
+	}()
+
+	format, width := fileColumn(tabularShortFormatFile, shortFormatFileTrucate, tabularShortBreak, tabularShortFormatHead)
+	if format != tabularShortFormatFile || width != 30 {
+		t.Errorf("Expected unchanged format got %q %d", format, width)
+	}
+
+	columns = 120
+	format, width = fileColumn(tabularShortFormatFile, shortFormatFileTrucate, tabularShortBreak, tabularShortFormatHead)
+	if width != 71 || len(fmt.Sprintf(format, "", 0, 0, 0, 0, 0)) != 121 {
+		t.Errorf("Expected the rows to fill 120 columns got %q %d", format, width)
+	}
+
+	columns = 40
+	if _, width = fileColumn(tabularWideFormatFile, wideFormatFileTrucate, tabularWideBreak, tabularWideFormatHead); width != 26 {
+		t.Errorf("Expected the language column to be kept at %d got %d", minNameWidth, width)
+	}
+
+	FilenameWidth = 50
+	if _, width = fileColumn(tabularShortFormatFile, shortFormatFileTrucate, tabularShortBreak, tabularShortFormatHead); width != 50 {
+		t.Errorf("Expected 50 got %d", width)
+	}
+}
+
+func TestFileSummarizeTerminalWidth(t *testing.T) {
+	Files = true
+	NoCocomo = true
+	original := outputColumns
+	outputColumns = func() int { return 120 }
+	defer func() {
+		Files = false
+		NoCocomo = false
+		outputColumns = original
+	}()
+
+	newInput := func() chan *FileJob {
+		inputChan := make(chan *FileJob, 10)
+		inputChan <- &FileJob{Language: "Go", Location: "main.go", Lines: 10, Code: 8, Comment: 1, Blank: 1, Complexity: 2}
+		close(inputChan)
+		return inputChan
+	}
+
+	// The breaks and language rows are resized along with the rows of files
+	for name, got := range map[string]string{"short": fileSummarizeShort(newInput()), "wide": fileSummarizeLong(newInput())} {
+		for _, line := range strings.Split(strings.TrimSuffix(got, "\n"), "\n") {
+			if strings.HasPrefix(line, "Language") || strings.HasPrefix(line, "─") || strings.HasPrefix(line, "main.go") {
+				if width := utf8.RuneCountInString(line); width != 120 {
+					t.Errorf("Expected %s line to be 120 wide got %d %q", name, width, line)
+				}
+			}
+		}
+	}
+}
+
+func TestFileSummarizeComplexityColor(t *testing.T) {
+	Files = true
+	original := outputIsTerminal
+	outputIsTerminal = func() bool { return true }
+	defer func() {
+		Files = false
+		outputIsTerminal = original
+		os.Unsetenv("NO_COLOR")
+	}()
+
+	summarize := func() string {
+		inputChan := make(chan *FileJob, 10)
+		inputChan <- &FileJob{Language: "Go", Location: "simple.go", Lines: 10, Code: 10, Complexity: 1}
+		inputChan <- &FileJob{Language: "Go", Location: "busy.go", Lines: 10, Code: 10, Complexity: ComplexityWarning}
+		inputChan <- &FileJob{Language: "Go", Location: "hotspot.go", Lines: 10, Code: 10, Complexity: ComplexityHotspot}
+		close(inputChan)
+		return fileSummarizeShort(inputChan)
+	}
+
+	got := summarize()
+	for _, line := range []string{colorGreen + "simple.go", colorYellow + "busy.go", colorRed + "hotspot.go"} {
+		if !strings.Contains(got, line) {
+			t.Errorf("Expected %q in %s", line, got)
+		}
+	}
+
+	os.Setenv("NO_COLOR", "1")
+	if got := summarize(); strings.Contains(got, colorReset) {
+		t.Errorf("Expected no colour got %q", got)
+	}
+}
+
+func TestFileSummarizeLongTokens(t *testing.T) {
+	More = true
+	Files = true
+	defer func() {
+		More = false
+		Files = false
+	}()
+
+	modTime := time.Date(2018, 3, 9, 10, 30, 0, 0, time.UTC)
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "a.go", Lines: 10, Code: 10, Complexity: 2, Tokens: 7, ModTime: &modTime}
+	inputChan <- &FileJob{Language: "Go", Location: "b.go", Lines: 10, Code: 10, Complexity: 1, Tokens: 3}
+	close(inputChan)
+
+	got := summarizeOutput(inputChan)
+
+	for _, line := range []string{
+		strings.TrimSuffix(fmt.Sprintf(tabularWideFormatHead, "Language", "Files", "Lines", "Code", "Comments", "Blanks", "Complexity", "Tokens", "Complexity/Lines"), "\n") + "   Modified\n",
+		fmt.Sprintf(tabularWideFormatBody, "Go", 2, 20, 20, 0, 0, 3, 10, 30.0),
+		fmt.Sprintf(tabularWideFormatFile, "a.go", 10, 10, 0, 0, 2, 7, 20.0, "2018-03-09"),
+		fmt.Sprintf(tabularWideFormatFile, "b.go", 10, 10, 0, 0, 1, 3, 10.0, ""),
+		resizeBreak(tabularWideBreak, wideModifiedWidth),
+	} {
+		if !strings.Contains(got, line) {
+			t.Errorf("Expected %q in %s", line, got)
+		}
+	}
+}
+
+func TestThroughput(t *testing.T) {
+	if got := throughput(1024*1024, 0); got != 0 {
+		t.Errorf("Expected 0 with no time got %f", got)
+	}
+
+	if got := throughput(10*1024*1024, 2*int64(time.Second)); got != 5 {
+		t.Errorf("Expected 5 got %f", got)
+	}
+}
+
+func TestFileSummarizeTrace(t *testing.T) {
+	Trace = true
+	defer func() { Trace = false }()
+
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "main.go", Lines: 10, Code: 10, Bytes: 100}
+	close(inputChan)
+
+	if got := summarizeOutput(inputChan); !strings.Contains(got, fmt.Sprintf(tabularShortFormatBody, "Go", 1, 10, 10, 0, 0, 0)) {
+		t.Errorf("Expected the summary to be unchanged got %s", got)
+	}
+}
+
+func TestComplexityPerLine(t *testing.T) {
+	if got := complexityPerLine(5, 0); got != 0 {
+		t.Errorf("Expected 0 for no code got %f", got)
+	}
+
+	if got := complexityPerLine(5, 10); got != 0.5 {
+		t.Errorf("Expected 0.5 got %f", got)
+	}
+}
+
+func TestSortComplexityPerLine(t *testing.T) {
+	SortBy = "complexity-per-line"
+	defer func() { SortBy = "" }()
+
+	language := []LanguageSummary{
+		{Name: "Big", Code: 1000, Complexity: 100},
+		{Name: "Empty", Code: 0, Complexity: 0},
+		{Name: "Dense", Code: 10, Complexity: 5},
+	}
+	sortLanguageSummary(language)
+
+	if language[0].Name != "Dense" || language[1].Name != "Big" || language[2].Name != "Empty" {
+		t.Errorf("Unexpected order %v", language)
+	}
+
+	summary := LanguageSummary{Files: []*FileJob{
+		{Location: "big.go", Code: 1000, Complexity: 100},
+		{Location: "dense.go", Code: 10, Complexity: 5},
+	}}
+	sortSummaryFiles(&summary)
+
+	if summary.Files[0].Location != "dense.go" {
+		t.Errorf("Expected dense.go first got %s", summary.Files[0].Location)
+	}
+}
+
+func TestSortLanguageSummaryTies(t *testing.T) {
+	SortBy = "complexity"
+	defer func() { SortBy = "" }()
+
+	for i := 0; i < 10; i++ {
+		language := []LanguageSummary{
+			{Name: "C"}, {Name: "A"}, {Name: "D", Complexity: 1}, {Name: "B"},
+		}
+		sortLanguageSummary(language)
+
+		if language[0].Name != "D" || language[1].Name != "A" || language[2].Name != "B" || language[3].Name != "C" {
+			t.Errorf("Unexpected order %v", language)
+		}
+	}
+}
+
+func TestSortSummaryFilesTies(t *testing.T) {
+	SortBy = "complexity"
+	defer func() { SortBy = "" }()
+
+	for i := 0; i < 10; i++ {
+		summary := LanguageSummary{Files: []*FileJob{
+			{Location: "c.go"}, {Location: "a.go"}, {Location: "d.go", Complexity: 1}, {Location: "b.go"},
+		}}
+		sortSummaryFiles(&summary)
+
+		if summary.Files[0].Location != "d.go" || summary.Files[1].Location != "a.go" || summary.Files[2].Location != "b.go" || summary.Files[3].Location != "c.go" {
+			t.Errorf("Unexpected order %v", summary.Files)
+		}
+	}
+}
+
+func TestPercentage(t *testing.T) {
+	if got := percentage(1, 4); got != 25 {
+		t.Errorf("Expected 25 got %v", got)
+	}
+
+	if got := percentage(0, 0); got != 0 {
+		t.Errorf("Expected 0 for no total got %v", got)
+	}
+}
+
+func TestFileSummarizePercent(t *testing.T) {
+	Percent = true
+	defer func() {
+		Percent = false
+		PercentOf = PercentCode
+		Format = ""
+	}()
+
+	newInput := func() chan *FileJob {
+		inputChan := make(chan *FileJob, 10)
+		inputChan <- &FileJob{Language: "Go", Location: "main.go", Lines: 10, Code: 6, Blank: 4}
+		inputChan <- &FileJob{Language: "Java", Location: "Main.java", Lines: 10, Code: 2, Comment: 8}
+		close(inputChan)
+		return inputChan
+	}
+
+	got := fileSummarizeShort(newInput())
+	for _, line := range []string{"  %Code\n", " 75.0%\n", " 25.0%\n", "100.0%\n"} {
+		if !strings.Contains(got, line) {
+			t.Errorf("Expected %q in output got %s", line, got)
+		}
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(got), "\n") {
+		if width := utf8.RuneCountInString(line); width > utf8.RuneCountInString(tabularShortBreak)-1 {
+			t.Errorf("Expected line to fit in the table got %d %s", width, line)
+		}
+	}
+
+	PercentOf = PercentLines
+	if got := fileSummarizeLong(newInput()); !strings.Contains(got, "%Lines\n") || !strings.Contains(got, " 50.0%\n") {
+		t.Errorf("Expected percentages of lines got %s", got)
+	}
+
+	Files = true
+	got = fileSummarizeLong(newInput())
+	Files = false
+	for _, row := range []string{
+		fmt.Sprintf(tabularWideFormatFilePercent, "main.go", 10, 6, 0, 4, 0, 0, 0.0, ""),
+		fmt.Sprintf(tabularWideFormatFilePercent, "Main.java", 10, 2, 8, 0, 0, 0, 0.0, ""),
+	} {
+		if !strings.Contains(got, strings.TrimSuffix(row, "\n")+"  50.0%\n") {
+			t.Errorf("Expected %q with the percentage in %s", row, got)
+		}
+	}
+
+	for _, line := range strings.Split(strings.TrimSpace(got), "\n") {
+		if strings.Contains(line, ".go") || strings.Contains(line, ".java") {
+			if width := utf8.RuneCountInString(line); width != utf8.RuneCountInString(tabularWideBreak)-1+wideModifiedWidth {
+				t.Errorf("Expected file row to be as wide as the table got %d %s", width, line)
+			}
+		}
+	}
+
+	Format = "json"
+	var res jsonSummary
+	json.Unmarshal([]byte(summarizeOutput(newInput())), &res)
+	if len(res.Languages) != 2 || res.Languages[0].Percentage != 50 {
+		t.Errorf("Expected percentage of lines got %+v", res.Languages)
+	}
+}
+
+func TestTotalOnly(t *testing.T) {
+	TotalOnly = true
+	defer func() {
+		TotalOnly = false
+		Format = ""
+	}()
+
+	newInput := func() chan *FileJob {
+		inputChan := make(chan *FileJob, 10)
+		inputChan <- &FileJob{Language: "Go", Location: "main.go", Lines: 10, Code: 8, Blank: 2, Complexity: 3}
+		inputChan <- &FileJob{Language: "Java", Location: "Main.java", Lines: 5, Code: 5}
+		close(inputChan)
+		return inputChan
+	}
+
+	got := summarizeOutput(newInput())
+	if strings.Contains(got, "Go") || strings.Contains(got, "Java") || strings.Contains(got, "Estimated Cost") {
+		t.Errorf("Expected only the total got %s", got)
+	}
+
+	expected := fmt.Sprintf(tabularShortFormatBody, "Total", 2, 15, 13, 0, 2, 3)
+	if !strings.Contains(got, expected) {
+		t.Errorf("Expected %s got %s", expected, got)
+	}
+
+	Format = "wide"
+	if got := summarizeOutput(newInput()); strings.Contains(got, "Go") || !strings.Contains(got, "Complexity/Lines") {
+		t.Errorf("Expected only the wide total got %s", got)
+	}
+
+	Format = "json"
+	var res Total
+	if err := json.Unmarshal([]byte(summarizeOutput(newInput())), &res); err != nil {
+		t.Fatalf("Expected valid JSON got %s", err)
+	}
+
+	if res.Files != 2 || res.Code != 13 || res.Complexity != 3 {
+		t.Errorf("Unexpected total %+v", res)
+	}
+}
+
+func TestAggregateTotal(t *testing.T) {
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "main.go", Lines: 10, Code: 8, Comment: 1, Blank: 1, Complexity: 2}
+	inputChan <- &FileJob{Language: "Go", Location: "other.go", Lines: 4, Code: 4, Complexity: 2}
+	inputChan <- &FileJob{Language: "Java", Location: "Main.java", Lines: 6, Code: 5, Blank: 1}
+	close(inputChan)
+
+	language := aggregateLanguageSummary(inputChan)
+	if language[0].Name != "Go" || language[0].WeightedComplexity != 75 {
+		t.Errorf("Expected Go with weighted complexity 75 got %+v", language[0])
+	}
+
+	total := aggregateTotal(language)
+	if total.Files != 3 || total.Lines != 20 || total.Code != 17 || total.Comment != 1 || total.Blank != 2 || total.Complexity != 4 {
+		t.Errorf("Unexpected total %+v", total)
+	}
+
+	if total.AverageLines != 20.0/3 {
+		t.Errorf("Expected %v got %v", 20.0/3, total.AverageLines)
+	}
+}
+
+func TestSortByName(t *testing.T) {
+	SortBy = "name"
+	defer func() { SortBy = "" }()
+
+	language := []LanguageSummary{
+		{Name: "gitignore", Count: 5}, {Name: "C", Count: 1}, {Name: "Zig", Count: 3}, {Name: "awk", Count: 2},
+	}
+	sortLanguageSummary(language)
+
+	if language[0].Name != "awk" || language[1].Name != "C" || language[2].Name != "gitignore" || language[3].Name != "Zig" {
+		t.Errorf("Unexpected order %v", language)
+	}
+
+	summary := LanguageSummary{Files: []*FileJob{
+		{Location: "b.go", Lines: 1}, {Location: "C.go", Lines: 3}, {Location: "a.go", Lines: 2},
+	}}
+	sortSummaryFiles(&summary)
+
+	if summary.Files[0].Location != "a.go" || summary.Files[1].Location != "b.go" || summary.Files[2].Location != "C.go" {
+		t.Errorf("Unexpected order %v", summary.Files)
+	}
+}
+
+func TestSortReverse(t *testing.T) {
+	SortBy, Reverse = "complexity", true
+	defer func() { SortBy, Reverse = "", false }()
+
+	language := []LanguageSummary{
+		{Name: "C"}, {Name: "A"}, {Name: "D", Complexity: 1}, {Name: "B"},
+	}
+	sortLanguageSummary(language)
+
+	if language[0].Name != "C" || language[1].Name != "B" || language[2].Name != "A" || language[3].Name != "D" {
+		t.Errorf("Unexpected order %v", language)
+	}
+
+	summary := LanguageSummary{Files: []*FileJob{
+		{Location: "c.go", Complexity: 2}, {Location: "a.go"}, {Location: "d.go", Complexity: 1},
+	}}
+	sortSummaryFiles(&summary)
+
+	if summary.Files[0].Location != "a.go" || summary.Files[1].Location != "d.go" || summary.Files[2].Location != "c.go" {
+		t.Errorf("Unexpected order %v", summary.Files)
+	}
+
+	SortBy = "name"
+	sortLanguageSummary(language)
+
+	if language[0].Name != "D" || language[3].Name != "A" {
+		t.Errorf("Unexpected order %v", language)
+	}
+}
+
+func TestSkippedSummarize(t *testing.T) {
+	ShowSkipped = true
+	skipped.Add("image.go", SkipBinary)
+	defer func() {
+		ShowSkipped = false
+		skipped.Reset()
+	}()
+
+	inputChan := make(chan *FileJob, 10)
+	close(inputChan)
+	got := fileSummarizeShort(inputChan)
+
+	if !strings.Contains(got, "Skipped Files (1)") || !strings.Contains(got, "image.go") || !strings.Contains(got, SkipBinary) {
+		t.Errorf("Expected skipped file in output got %s", got)
+	}
+}
+
+func TestImportsSummarize(t *testing.T) {
+	var str strings.Builder
+	language := []LanguageSummary{{Name: "Go", Imports: 3}, {Name: "Java", Imports: 4}}
+	importsSummarize(&str, tabularShortBreak, tabularShortFormatHeadImports, tabularShortFormatImports, language)
+
+	for _, line := range []string{fmt.Sprintf(tabularShortFormatImports, "Go", 3), fmt.Sprintf(tabularShortFormatImports, "Total", 7)} {
+		if !strings.Contains(str.String(), line) {
+			t.Errorf("Expected %q in output got %s", line, str.String())
+		}
+	}
+}
+
+func TestToJsonSkipped(t *testing.T) {
+	skipped.Add("image.go", SkipBinary)
+	defer skipped.Reset()
+
+	inputChan := make(chan *FileJob, 10)
+	close(inputChan)
+
+	var res jsonSummary
+	json.Unmarshal([]byte(toJson(inputChan)), &res)
+
+	if res.Total.Skipped != 1 || len(res.Skipped) != 0 {
+		t.Errorf("Expected skipped count without list got %+v", res)
+	}
+}
+
+func TestToJsonULOC(t *testing.T) {
+	ULOC = true
+	defer func() { ULOC = false }()
+	defer uniqueLines.Reset()
+
+	job := &FileJob{Language: "Go", Lines: 2, Code: 2, codeLineHashes: []uint64{1, 1}}
+	uniqueLines.Add(job)
+
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- job
+	close(inputChan)
+
+	var res jsonSummary
+	if err := json.Unmarshal([]byte(toJson(inputChan)), &res); err != nil {
+		t.Fatalf("Expected valid JSON got %s", err)
+	}
+
+	if res.Total.ULOC != 1 || res.Languages[0].ULOC != 1 {
+		t.Errorf("Expected ULOC of 1 got %d %d", res.Total.ULOC, res.Languages[0].ULOC)
+	}
+}
+
+func TestToJsonDuplicateGroups(t *testing.T) {
+	DuplicateGroups = true
+	defer func() { DuplicateGroups = false }()
+	defer duplicates.Reset()
+
+	duplicates.Reset()
+	duplicates.Add(1, []byte("hash"), "main.go")
+	duplicates.AddDuplicate(1, []byte("hash"), "copy.go")
+
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "main.go", Lines: 1}
+	close(inputChan)
+
+	var res jsonSummary
+	if err := json.Unmarshal([]byte(toJson(inputChan)), &res); err != nil {
+		t.Fatalf("Expected valid JSON got %s", err)
+	}
+
+	if len(res.Duplicates) != 1 || len(res.Duplicates[0]) != 2 || res.Duplicates[0][0] != "copy.go" || res.Duplicates[0][1] != "main.go" {
+		t.Errorf("Expected a single group of copy.go and main.go got %v", res.Duplicates)
+	}
+}
+
+func TestComplexityHistogram(t *testing.T) {
+	files := []*FileJob{{Complexity: 0}, {Complexity: 1}, {Complexity: 5}, {Complexity: 6}, {Complexity: 20}, {Complexity: 21}, {Complexity: 100}}
+
+	histogram := complexityHistogram(files)
+	expected := []int64{1, 2, 2, 2}
+	if !reflect.DeepEqual(histogram, expected) {
+		t.Errorf("Expected %v got %v", expected, histogram)
+	}
+}
+
+func TestToJsonComplexityHistogram(t *testing.T) {
+	ComplexityHistogram = true
+	defer func() { ComplexityHistogram = false }()
+
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Complexity: 3}
+	inputChan <- &FileJob{Language: "Go", Complexity: 30}
+	close(inputChan)
+
+	var res jsonSummary
+	if err := json.Unmarshal([]byte(toJson(inputChan)), &res); err != nil {
+		t.Fatalf("Expected valid JSON got %s", err)
+	}
+
+	if !reflect.DeepEqual(res.ComplexityBuckets, complexityBuckets) {
+		t.Errorf("Expected %v got %v", complexityBuckets, res.ComplexityBuckets)
+	}
+
+	expected := []int64{0, 1, 0, 1}
+	if !reflect.DeepEqual(res.Languages[0].ComplexityHistogram, expected) {
+		t.Errorf("Expected %v got %v", expected, res.Languages[0].ComplexityHistogram)
+	}
+}
+
+func TestJsonToYaml(t *testing.T) {
+	yaml, err := jsonToYaml([]byte(`{"Name":"Go \"lang\"","Count":2,"Binary":false,"Hash":null,"Empty":[],"Object":{},"Nested":{"Value":1.5},"Files":[{"Location":"a.go","Lines":[1,2]}],"Groups":[["a","b"]]}`))
+	if err != nil {
+		t.Fatalf("Expected no error got %s", err)
+	}
+
+	expected := `Name: "Go \"lang\""
+Count: 2
+Binary: false
+Hash: null
+Empty: []
+Object: {}
+Nested:
+  Value: 1.5
+Files:
+- Location: "a.go"
+  Lines:
+  - 1
+  - 2
+Groups:
+- - "a"
+  - "b"
+`
+	if yaml != expected {
+		t.Errorf("Expected %s got %s", expected, yaml)
+	}
+}
+
+func TestToYaml(t *testing.T) {
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Lines: 10, Code: 6, Comment: 2, Blank: 2, Complexity: 3}
+	close(inputChan)
+
+	yaml := toYaml(inputChan)
+
+	for _, line := range []string{"Languages:\n- Name: \"Go\"\n", "  Code: 6\n", "Total:\n  Files: 1\n"} {
+		if !strings.Contains(yaml, line) {
+			t.Errorf("Expected %s in %s", line, yaml)
+		}
+	}
+}
+
+func TestToTemplate(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "summary.tmpl")
+	ioutil.WriteFile(name, []byte("{{range .Languages}}{{.Name}} {{.Count}} {{.Code}}\n{{end}}Total {{.Total.Files}} {{.Total.Code}}"), 0600)
+
+	tmpl, err := parseFormatTemplate(name)
+	if err != nil {
+		t.Fatalf("Expected no error got %s", err)
+	}
+
+	formatTemplate = tmpl
+	defer func() { formatTemplate = nil }()
+
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Code: 6}
+	inputChan <- &FileJob{Language: "Go", Code: 4}
+	inputChan <- &FileJob{Language: "Java", Code: 1}
+	close(inputChan)
+
+	expected := "Go 2 10\nJava 1 1\nTotal 3 11"
+	if res, _, err := fileSummarize(inputChan); err != nil || res != expected {
+		t.Errorf("Expected %s got %s %v", expected, res, err)
+	}
+
+	// A template which fails to execute is returned as an error rather than exiting
+	formatTemplate, _ = parseFormatTemplate(name)
+	formatTemplate = template.Must(formatTemplate.Parse("{{.Missing}}"))
+	inputChan = make(chan *FileJob, 10)
+	close(inputChan)
+	if _, _, err := fileSummarize(inputChan); err == nil || !strings.Contains(err.Error(), "unable to execute format template") {
+		t.Errorf("Expected a template error got %v", err)
+	}
+
+	if _, err := parseFormatTemplate(filepath.Join(dir, "missing.tmpl")); err == nil {
+		t.Error("Expected error for missing template")
+	}
+}
+
+func TestFileSummarizeRatios(t *testing.T) {
+	Ratios = true
+	defer func() {
+		Ratios = false
+		Format = ""
+	}()
+
+	newInput := func() chan *FileJob {
+		inputChan := make(chan *FileJob, 10)
+		inputChan <- &FileJob{Language: "Go", Location: "main.go", Lines: 8, Code: 6, Comment: 1, Blank: 1}
+		inputChan <- &FileJob{Language: "Java", Location: "Main.java", Lines: 10, Code: 2, Comment: 8}
+		close(inputChan)
+		return inputChan
+	}
+
+	for _, got := range []string{fileSummarizeShort(newInput()), fileSummarizeLong(newInput())} {
+		for _, line := range []string{"Blanks   Comments       Code\n", "12.5%      12.5%      75.0%\n", "0.0%      80.0%      20.0%\n"} {
+			if !strings.Contains(got, line) {
+				t.Errorf("Expected %q in output got %s", line, got)
+			}
+		}
+	}
+
+	Format = "json"
+	var res jsonSummary
+	json.Unmarshal([]byte(summarizeOutput(newInput())), &res)
+	for _, language := range res.Languages {
+		if language.BlankRatio == nil || language.CommentRatio == nil || language.CodeRatio == nil {
+			t.Fatalf("Expected every ratio got %+v", language)
+		}
+
+		if language.Name == "Go" && (*language.BlankRatio != 12.5 || *language.CommentRatio != 12.5 || *language.CodeRatio != 75) {
+			t.Errorf("Expected ratios 12.5 12.5 75 got %v %v %v", *language.BlankRatio, *language.CommentRatio, *language.CodeRatio)
+		}
+	}
+
+	// A ratio of 0 is still written when asked for such as the blank lines of Java
+	if got := summarizeOutput(newInput()); !strings.Contains(got, `"BlankRatio":0,`) {
+		t.Errorf("Expected a blank ratio of 0 got %s", got)
+	}
+
+	// Neither the ratios nor the imports of each file are written unless asked for
+	Ratios = false
+	Files = true
+	defer func() { Files = false }()
+	got := summarizeOutput(newInput())
+	for _, field := range []string{"BlankRatio", "CommentRatio", "CodeRatio", "Imports"} {
+		if strings.Contains(got, field) {
+			t.Errorf("Expected no %s in output got %s", field, got)
+		}
+	}
+}
+
+func TestFileSummarizeLineLength(t *testing.T) {
+	LineLength = true
+	defer func() { LineLength = false }()
+
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "main.go", MaxLineLength: 80, lineLengthSum: 100, lineLengthLines: 4}
+	inputChan <- &FileJob{Language: "Go", Location: "long.go", MaxLineLength: 200, lineLengthSum: 300, lineLengthLines: 2}
+	inputChan <- &FileJob{Language: "Java", Location: "Main.java", MaxLineLength: 120, lineLengthSum: 200, lineLengthLines: 4}
+	close(inputChan)
+
+	got := fileSummarizeLong(inputChan)
+	for _, line := range []string{
+		fmt.Sprintf(tabularWideFormatLineLength, "Go", 400.0/6, 200),
+		fmt.Sprintf(tabularWideFormatLineLength, "Java", 50.0, 120),
+		fmt.Sprintf(tabularWideFormatLineLength, "Total", 60.0, 200),
+	} {
+		if !strings.Contains(got, line) {
+			t.Errorf("Expected %q in output got %s", line, got)
+		}
+	}
+}
+
+func TestFileSummarizeTests(t *testing.T) {
+	Tests = true
+	defer func() { Tests = false }()
+
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Location: "main.go", Code: 40}
+	inputChan <- &FileJob{Language: "Go" + TestsLanguageSuffix, Location: "main_test.go", Code: 10}
+	inputChan <- &FileJob{Language: "Java", Location: "Main.java", Code: 50}
+	close(inputChan)
+
+	got := fileSummarizeShort(inputChan)
+	for _, line := range []string{"Go (tests)", "Tests/Code\n", "40         10      25.0%\n", "90         10      11.1%\n"} {
+		if !strings.Contains(got, line) {
+			t.Errorf("Expected %q in output got %s", line, got)
+		}
+	}
+}
+
+func TestFileSummarizeLanguages(t *testing.T) {
+	input := func() chan *FileJob {
+		inputChan := make(chan *FileJob, 10)
+		inputChan <- &FileJob{Language: "Go", Location: "main.go", Lines: 3, Code: 2, Comment: 1}
+		inputChan <- &FileJob{Language: "Java", Location: "Main.java", Lines: 4, Code: 4}
+		close(inputChan)
+		return inputChan
+	}
+
+	if _, language, _ := fileSummarize(input()); language != nil {
+		t.Errorf("Expected no summary without a minimum comment ratio got %v", language)
+	}
+
+	MinCommentRatio = 0.1
+	defer func() { MinCommentRatio = 0 }()
+
+	result, language, err := fileSummarize(input())
+	if err != nil || !strings.Contains(result, "Java") {
+		t.Errorf("Expected the output to be written got %s %v", result, err)
+	}
+
+	if len(language) != 2 || language[0].Name != "Go" || language[0].Comment != 1 || language[1].Name != "Java" {
+		t.Errorf("Expected the summary of Go and Java got %v", language)
+	}
+}
+
+// Returns the output of fileSummarize for the formats which cannot fail
+func summarizeOutput(input chan *FileJob) string {
+	result, _, _ := fileSummarize(input)
+	return result
+}
@@ -0,0 +1,259 @@
+package processor
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// A file in a git tree which is read from git rather than the working tree
+type gitBlob struct {
+	object    string
+	location  string
+	language  string
+	extension string
+}
+
+// Checks the current directory is a git repository which contains the ref so
+// that a bad ref is reported before any processing starts
+func checkGitRef(ref string) error {
+	if !isGitRepository(".") {
+		return errors.New("--git-ref requires the current directory to be a git repository")
+	}
+
+	if err := exec.Command("git", "rev-parse", "--verify", "--quiet", ref+"^{tree}").Run(); err != nil {
+		return fmt.Errorf("unknown git ref: %s", ref)
+	}
+
+	return nil
+}
+
+// Returns true if the directory is inside a git repository
+func isGitRepository(dir string) bool {
+	return exec.Command("git", "-C", dir, "rev-parse", "--git-dir").Run() == nil
+}
+
+// Returns the directory git should be run in for the path along with the pathspec
+// which selects the path from that directory, so each path uses its own repository
+func gitPathspec(location string) (string, string) {
+	if info, err := os.Stat(location); err == nil && !info.IsDir() {
+		return filepath.Dir(location), filepath.Base(location)
+	}
+
+	return location, "."
+}
+
+// Walks the files under the paths which are tracked by git in the working tree, which
+// leaves out anything ignored or untracked without needing to check the ignore files
+func walkGitTracked(ctx context.Context, paths []string, output chan *FileJob) {
+	startTime := makeTimestampMilli()
+	defer close(output)
+
+	extensionLookup := getExtensionLookup()
+
+	var regex *regexp.Regexp
+	if Exclude != "" {
+		regex = regexp.MustCompile(Exclude)
+	}
+
+	for _, location := range paths {
+		dir, pathspec := gitPathspec(location)
+		out, err := exec.CommandContext(ctx, "git", "-C", dir, "ls-files", "-z", "--", pathspec).Output()
+		if err != nil {
+			if ctx.Err() == nil {
+				printError(fmt.Sprintf("error listing git tracked files: %s %s", location, err))
+			}
+			continue
+		}
+
+		for _, file := range strings.Split(string(out), "\x00") {
+			if ctx.Err() != nil {
+				break
+			}
+
+			if file == "" {
+				continue
+			}
+
+			if regex != nil && regex.Match([]byte(path.Base(file))) {
+				if Verbose {
+					printWarn("skipping file due to match exclude: " + file)
+				}
+				continue
+			}
+
+			if job, ok := newFileListJob(filepath.Join(dir, filepath.FromSlash(file)), extensionLookup); ok {
+				select {
+				case output <- job:
+				case <-ctx.Done():
+				}
+			}
+		}
+	}
+
+	if Debug {
+		printDebug(fmt.Sprintf("milliseconds to list git tracked files: %d", makeTimestampMilli()-startTime))
+	}
+}
+
+// Lists the regular files under the paths in the tree of the ref. Symlinks and
+// submodules are not included as they have no content to count
+func listGitBlobs(ctx context.Context, ref string, paths []string) ([]gitBlob, error) {
+	args := append([]string{"ls-tree", "-r", "-z", ref, "--"}, paths...)
+	out, err := exec.CommandContext(ctx, "git", args...).Output()
+	if err != nil {
+		return nil, err
+	}
+
+	blobs := []gitBlob{}
+	for _, entry := range bytes.Split(out, []byte{0}) {
+		// Each entry is <mode> SP <type> SP <object> TAB <path>
+		tab := bytes.IndexByte(entry, '\t')
+		if tab == -1 {
+			continue
+		}
+
+		fields := strings.Fields(string(entry[:tab]))
+		if len(fields) != 3 || fields[1] != "blob" || fields[0] == "120000" {
+			continue
+		}
+
+		blobs = append(blobs, gitBlob{object: fields[2], location: string(entry[tab+1:])})
+	}
+
+	return blobs, nil
+}
+
+// Walks the files in the tree of the ref under the paths reading their content from git
+// using a single cat-file process, which allows counting any commit without checking it out
+func walkGitRef(ctx context.Context, ref string, paths []string, output chan *FileJob) {
+	startTime := makeTimestampMilli()
+	defer close(output)
+
+	blobs, err := listGitBlobs(ctx, ref, paths)
+	if err != nil {
+		if Verbose {
+			printWarn(fmt.Sprintf("error listing git ref: %s %s", ref, err))
+		}
+		return
+	}
+
+	extensionLookup := getExtensionLookup()
+
+	var regex *regexp.Regexp
+	if Exclude != "" {
+		regex = regexp.MustCompile(Exclude)
+	}
+
+	wanted := []gitBlob{}
+	for _, blob := range blobs {
+		name := path.Base(blob.location)
+
+		if regex != nil && regex.Match([]byte(name)) {
+			if Verbose {
+				printWarn("skipping file due to match exclude: " + blob.location)
+			}
+			continue
+		}
+
+		if isExcludedPath(blob.location) {
+			if Verbose {
+				printWarn(fmt.Sprintf("skipping file due to match exclude path: %s", blob.location))
+			}
+			continue
+		}
+
+		language, extension, ok := detectLanguage(name, extensionLookup)
+		if !ok {
+			if Verbose {
+				printWarn(fmt.Sprintf("skipping file unknown extension: %s", name))
+			}
+			continue
+		}
+
+		blob.language = language
+		blob.extension = extension
+		wanted = append(wanted, blob)
+	}
+
+	cmd := exec.CommandContext(ctx, "git", "cat-file", "--batch")
+	stdin, _ := cmd.StdinPipe()
+	stdout, _ := cmd.StdoutPipe()
+	if err := cmd.Start(); err != nil {
+		if Verbose {
+			printWarn(fmt.Sprintf("error reading git ref: %s %s", ref, err))
+		}
+		return
+	}
+
+	// Written separately to avoid blocking on the output not being read
+	go func() {
+		for _, blob := range wanted {
+			fmt.Fprintln(stdin, blob.object)
+		}
+		stdin.Close()
+	}()
+
+	reader := bufio.NewReader(stdout)
+	for _, blob := range wanted {
+		content, err := readGitBlob(reader)
+		if err != nil {
+			if Verbose && ctx.Err() == nil {
+				printWarn(fmt.Sprintf("error reading git object: %s %s", blob.location, err))
+			}
+			break
+		}
+
+		if job, ok := newContentFileJob(blob.location, path.Base(blob.location), blob.extension, blob.language, content); ok {
+			select {
+			case output <- job:
+			case <-ctx.Done():
+			}
+		}
+	}
+
+	// Anything left must be read for cat-file to exit if it stopped early
+	io.Copy(ioutil.Discard, reader)
+	cmd.Wait()
+
+	if Debug {
+		printDebug(fmt.Sprintf("milliseconds to read git ref: %d", makeTimestampMilli()-startTime))
+	}
+}
+
+// Reads the content of the next object from the output of git cat-file --batch
+// which is a header of <object> SP <type> SP <size> LF followed by the content and LF
+func readGitBlob(reader *bufio.Reader) ([]byte, error) {
+	header, err := reader.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+
+	fields := strings.Fields(header)
+	if len(fields) != 3 {
+		return nil, fmt.Errorf("unexpected object header: %s", strings.TrimSpace(header))
+	}
+
+	size, err := strconv.Atoi(fields[2])
+	if err != nil {
+		return nil, err
+	}
+
+	content := make([]byte, size+1)
+	if _, err := io.ReadFull(reader, content); err != nil {
+		return nil, err
+	}
+
+	return content[:size], nil
+}
@@ -0,0 +1,148 @@
+package processor
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// Creates a git repository in a temporary directory and changes into it
+// returning a function to change back and remove the repository
+func createGitRepository(t *testing.T) func() {
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not available")
+	}
+
+	dir, _ := ioutil.TempDir("", "scc-test")
+	wd, _ := os.Getwd()
+	os.Chdir(dir)
+
+	git(t, "init", "-q")
+	return func() {
+		os.Chdir(wd)
+		os.RemoveAll(dir)
+	}
+}
+
+func git(t *testing.T, args ...string) {
+	args = append([]string{"-c", "user.name=scc", "-c", "user.email=scc@example.com"}, args...)
+	if out, err := exec.Command("git", args...).CombinedOutput(); err != nil {
+		t.Fatalf("git %v failed: %s %s", args, err, out)
+	}
+}
+
+func TestWalkGitRef(t *testing.T) {
+	ProcessConstants()
+	defer createGitRepository(t)()
+
+	os.MkdirAll("src", 0700)
+	ioutil.WriteFile(filepath.Join("src", "main.go"), []byte("package main\n"), 0600)
+	ioutil.WriteFile("run", []byte("#!/usr/bin/env python\nprint(1)\n"), 0600)
+	os.Symlink("run", "link.py")
+	git(t, "add", ".")
+	git(t, "commit", "-q", "-m", "first")
+
+	ioutil.WriteFile(filepath.Join("src", "main.go"), []byte("package main\n\nfunc main() {}\n"), 0600)
+	ioutil.WriteFile(filepath.Join("src", "other.go"), []byte("package main\n"), 0600)
+	git(t, "add", ".")
+	git(t, "commit", "-q", "-m", "second")
+
+	if err := checkGitRef("HEAD~1"); err != nil {
+		t.Fatalf("Expected no error got %s", err)
+	}
+
+	if err := checkGitRef("missing"); err == nil {
+		t.Error("Expected error for unknown ref")
+	}
+
+	walk := func(ref string, paths []string) map[string]int64 {
+		output := make(chan *FileJob, 10)
+		walkGitRef(context.Background(), ref, paths, output)
+
+		got := map[string]int64{}
+		for job := range output {
+			CountStats(job)
+			got[job.Language+" "+job.Location] = job.Lines
+		}
+		return got
+	}
+
+	expected := map[string]int64{"Go src/main.go": 1, "Python run": 2}
+	if got := walk("HEAD~1", []string{"."}); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v got %v", expected, got)
+	}
+
+	expected = map[string]int64{"Go src/main.go": 3, "Go src/other.go": 1}
+	if got := walk("HEAD", []string{"src"}); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v got %v", expected, got)
+	}
+}
+
+func TestWalkGitTracked(t *testing.T) {
+	ProcessConstants()
+	defer createGitRepository(t)()
+
+	os.MkdirAll("src", 0700)
+	ioutil.WriteFile(filepath.Join("src", "main.go"), []byte("package main\n"), 0600)
+	ioutil.WriteFile("tracked.py", []byte("print(1)\n"), 0600)
+	git(t, "add", ".")
+	git(t, "commit", "-q", "-m", "first")
+
+	ioutil.WriteFile("untracked.go", []byte("package main\n"), 0600)
+	ioutil.WriteFile(".gitignore", []byte("ignored.go\n"), 0600)
+	ioutil.WriteFile("ignored.go", []byte("package main\n"), 0600)
+
+	walk := func(paths []string) []string {
+		output := make(chan *FileJob, 10)
+		walkGitTracked(context.Background(), paths, output)
+
+		got := []string{}
+		for job := range output {
+			got = append(got, job.Language+" "+job.Location)
+		}
+		sort.Strings(got)
+		return got
+	}
+
+	expected := []string{"Go src/main.go", "Python tracked.py"}
+	if got := walk([]string{"."}); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v got %v", expected, got)
+	}
+
+	expected = []string{"Go src/main.go"}
+	if got := walk([]string{"src"}); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v got %v", expected, got)
+	}
+
+	expected = []string{"Python tracked.py"}
+	if got := walk([]string{"tracked.py", "untracked.go"}); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v got %v", expected, got)
+	}
+
+	// Each path is listed from its own repository rather than the current directory
+	repository, _ := os.Getwd()
+	os.Chdir(os.TempDir())
+
+	expected = []string{"Go " + filepath.Join(repository, "src", "main.go")}
+	if got := walk([]string{filepath.Join(repository, "src")}); !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v got %v", expected, got)
+	}
+}
+
+func TestCheckGitRefNotRepository(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	wd, _ := os.Getwd()
+	defer os.Chdir(wd)
+	os.Chdir(dir)
+
+	if err := checkGitRef("HEAD"); err == nil {
+		t.Error("Expected error outside of a git repository")
+	}
+}
@@ -0,0 +1,206 @@
+package processor
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"github.com/monochromegane/go-gitignore"
+)
+
+// Returns true if the path contains any of the metacharacters of a glob pattern
+func isGlob(path string) bool {
+	return strings.ContainsAny(path, "*?[")
+}
+
+// Splits a glob pattern into the directory before its first segment containing a metacharacter,
+// which is where it is walked from, and the segments which are matched under that directory
+func splitGlob(pattern string) (string, []string) {
+	segments := strings.Split(filepath.ToSlash(pattern), "/")
+
+	base := []string{}
+	for len(segments) > 1 && !isGlob(segments[0]) {
+		base = append(base, segments[0])
+		segments = segments[1:]
+	}
+
+	if len(base) == 0 {
+		return ".", segments
+	}
+
+	// A pattern such as /src/*.go starts with an empty segment for the root
+	if base[0] == "" {
+		return filepath.FromSlash("/" + strings.Join(base[1:], "/")), segments
+	}
+
+	return filepath.FromSlash(strings.Join(base, "/")), segments
+}
+
+// Matches the segments of a path against the segments of a glob pattern where ** matches
+// zero or more whole segments and the rest are matched using filepath.Match. As in a shell
+// names starting with a . are only matched by a pattern segment which starts with one
+func matchGlob(pattern []string, segments []string) bool {
+	if len(pattern) == 0 {
+		return len(segments) == 0
+	}
+
+	if pattern[0] == "**" {
+		if matchGlob(pattern[1:], segments) {
+			return true
+		}
+
+		return len(segments) != 0 && !strings.HasPrefix(segments[0], ".") && matchGlob(pattern, segments[1:])
+	}
+
+	if len(segments) == 0 {
+		return false
+	}
+
+	if strings.HasPrefix(segments[0], ".") && !strings.HasPrefix(pattern[0], ".") {
+		return false
+	}
+
+	if ok, _ := filepath.Match(pattern[0], segments[0]); !ok {
+		return false
+	}
+
+	return matchGlob(pattern[1:], segments[1:])
+}
+
+// Returns true if the walker would skip the file or directory because of --exclude-dir,
+// --not-match, --exclude-path or an ignore file so that a glob matches the same files
+func isGlobExcluded(path string, isDir bool, regex *regexp.Regexp, ignores []gitignore.IgnoreMatcher) bool {
+	if isDir {
+		for _, black := range PathBlacklist {
+			if strings.HasPrefix(path, black) {
+				return true
+			}
+		}
+
+		if isExcludedDir(path) {
+			return true
+		}
+	}
+
+	if regex != nil && regex.MatchString(filepath.Base(path)) {
+		return true
+	}
+
+	return isExcludedPath(path) || isIgnored(ignores, path, isDir)
+}
+
+// Returns the files and directories matching the glob pattern in the order they are walked
+// which allows patterns such as src/**/*.go to be used when the shell does not expand **.
+// Anything the walker would skip is left out and without a ** only as many directories
+// deep as the pattern has segments are walked. As in the walker symlinked files are matched
+// while symlinked directories are only walked into or matched when FollowSymlinks is set
+func expandGlob(pattern string) []string {
+	base, segments := splitGlob(pattern)
+	matches := []string{}
+
+	hidden, recursive := false, false
+	for _, segment := range segments {
+		hidden = hidden || strings.HasPrefix(segment, ".")
+		recursive = recursive || segment == "**"
+	}
+
+	var regex *regexp.Regexp
+	if Exclude != "" {
+		regex = regexp.MustCompile(Exclude)
+	}
+
+	// The ignore rules which apply to the contents of each directory as in walkDirectory
+	ignores := loadIgnoreFiles(base)
+	if global, ok := loadGlobalGitIgnore(base); ok {
+		ignores = append(ignores, global)
+	}
+	dirIgnores := map[string][]gitignore.IgnoreMatcher{base: ignores}
+
+	// Each directory is only walked once so symlinks which loop back cannot walk forever
+	var visited *visitedDirs
+	if FollowSymlinks {
+		visited = newVisitedDirs()
+		visited.visit(base)
+	}
+
+	// Walks the directory in lexical order adding the paths which match before walking into
+	// the directories which could contain more matches
+	var walk func(dir string)
+	walk = func(dir string) {
+		all, err := ioutil.ReadDir(dir)
+		if err != nil {
+			return
+		}
+
+		for _, info := range all {
+			path := filepath.Join(dir, info.Name())
+
+			if info.Mode()&os.ModeSymlink != 0 {
+				target, err := os.Stat(path)
+				if err != nil || (target.IsDir() && visited == nil) {
+					if Verbose {
+						printWarn(fmt.Sprintf("skipping symlink while expanding pattern: %s", path))
+					}
+					continue
+				}
+				info = target
+			}
+
+			rel, err := filepath.Rel(base, path)
+			if err != nil {
+				continue
+			}
+			relSegments := strings.Split(filepath.ToSlash(rel), "/")
+
+			if isGlobExcluded(path, info.IsDir(), regex, dirIgnores[dir]) {
+				if Verbose {
+					printWarn(fmt.Sprintf("skipping due to exclusion while expanding pattern: %s", path))
+				}
+				continue
+			}
+
+			if matchGlob(segments, relSegments) {
+				matches = append(matches, path)
+			}
+
+			// Hidden directories such as .git can only match a pattern which names them and
+			// nothing under a directory as deep as the pattern can match without a **
+			if !info.IsDir() || (!hidden && strings.HasPrefix(info.Name(), ".")) || (!recursive && len(relSegments) >= len(segments)) {
+				continue
+			}
+
+			if visited != nil && !visited.visit(path) {
+				continue
+			}
+
+			dirIgnores[path] = append(append([]gitignore.IgnoreMatcher{}, dirIgnores[dir]...), loadIgnoreFiles(path)...)
+			walk(path)
+		}
+	}
+	walk(base)
+
+	return matches
+}
+
+// Replaces each path which is a glob pattern with the paths it matches keeping every
+// other path as it is. A path which exists is never treated as a pattern
+func expandGlobs(paths []string) []string {
+	expanded := []string{}
+	for _, path := range paths {
+		if _, err := os.Stat(path); err == nil || !isGlob(path) {
+			expanded = append(expanded, path)
+			continue
+		}
+
+		matches := expandGlob(path)
+		if len(matches) == 0 && Verbose {
+			printWarn(fmt.Sprintf("skipping pattern with no matches: %s", path))
+		}
+		expanded = append(expanded, matches...)
+	}
+
+	return expanded
+}
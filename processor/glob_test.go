@@ -0,0 +1,175 @@
+package processor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSplitGlob(t *testing.T) {
+	cases := map[string][]string{
+		"src/**/*.go":  {"src", "**/*.go"},
+		"*.go":         {".", "*.go"},
+		"a/b/c?.txt":   {filepath.FromSlash("a/b"), "c?.txt"},
+		"/src/[ab].go": {filepath.FromSlash("/src"), "[ab].go"},
+	}
+
+	for pattern, expected := range cases {
+		base, segments := splitGlob(pattern)
+		if base != expected[0] || strings.Join(segments, "/") != expected[1] {
+			t.Errorf("Expected %v for %s got %s %v", expected, pattern, base, segments)
+		}
+	}
+}
+
+func TestMatchGlob(t *testing.T) {
+	cases := []struct {
+		pattern string
+		path    string
+		match   bool
+	}{
+		{"**/*.go", "main.go", true},
+		{"**/*.go", "a/b/main.go", true},
+		{"**/*.go", "a/b/main.java", false},
+		{"a/**/b/*.go", "a/b/main.go", true},
+		{"a/**/b/*.go", "a/x/y/b/main.go", true},
+		{"a/**/b/*.go", "a/x/y/c/main.go", false},
+		{"*.go", "a/main.go", false},
+		{"**", "a/b/c", true},
+		{"**/*.go", ".git/main.go", false},
+		{"*", ".env", false},
+		{".*", ".env", true},
+		{"**/.github/*.yml", "x/.github/ci.yml", true},
+	}
+
+	for _, c := range cases {
+		if got := matchGlob(strings.Split(c.pattern, "/"), strings.Split(c.path, "/")); got != c.match {
+			t.Errorf("Expected %t for %s matching %s got %t", c.match, c.pattern, c.path, got)
+		}
+	}
+}
+
+func TestExpandGlobs(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{"main.go", "lib/util.go", "lib/deep/more.go", "lib/README.md", ".hidden/secret.go"} {
+		location := filepath.Join(dir, filepath.FromSlash(name))
+		os.MkdirAll(filepath.Dir(location), 0700)
+		ioutil.WriteFile(location, []byte("package main\n"), 0600)
+	}
+
+	got := expandGlobs([]string{filepath.Join(dir, "**", "*.go"), filepath.Join(dir, "lib", "README.md"), "missing"})
+	expected := []string{
+		filepath.Join(dir, "lib", "deep", "more.go"),
+		filepath.Join(dir, "lib", "util.go"),
+		filepath.Join(dir, "main.go"),
+		filepath.Join(dir, "lib", "README.md"),
+		"missing",
+	}
+
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v got %v", expected, got)
+	}
+
+	if got := expandGlobs([]string{filepath.Join(dir, "*.java")}); len(got) != 0 {
+		t.Errorf("Expected no matches got %v", got)
+	}
+}
+
+func TestExpandGlobsExcluded(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{"main.go", "vendor/lib.go", "lib/util.go", "lib/ignored.go", "lib/util_test.go"} {
+		location := filepath.Join(dir, filepath.FromSlash(name))
+		os.MkdirAll(filepath.Dir(location), 0700)
+		ioutil.WriteFile(location, []byte("package main\n"), 0600)
+	}
+	ioutil.WriteFile(filepath.Join(dir, "lib", ".gitignore"), []byte("ignored.go\n"), 0600)
+
+	originalExcludeDir, originalExclude := ExcludeDir, Exclude
+	defer func() { ExcludeDir, Exclude = originalExcludeDir, originalExclude }()
+	ExcludeDir = []string{"vendor"}
+	Exclude = "_test"
+
+	got := expandGlobs([]string{filepath.Join(dir, "**", "*.go")})
+	expected := []string{filepath.Join(dir, "lib", "util.go"), filepath.Join(dir, "main.go")}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v got %v", expected, got)
+	}
+
+	// Without a ** nothing deeper than the pattern is matched
+	if got := expandGlobs([]string{filepath.Join(dir, "*.go")}); !reflect.DeepEqual(got, []string{filepath.Join(dir, "main.go")}) {
+		t.Errorf("Expected only main.go got %v", got)
+	}
+}
+
+func TestExpandGlobsClasses(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{"a1.go", "a2.go", "a10.go", "b1.go", "c.go", "lib/x.go", "lib/y.go", "lib/xy.go"} {
+		location := filepath.Join(dir, filepath.FromSlash(name))
+		os.MkdirAll(filepath.Dir(location), 0700)
+		ioutil.WriteFile(location, []byte("package main\n"), 0600)
+	}
+
+	cases := map[string][]string{
+		"[ab]1.go":     {"a1.go", "b1.go"},
+		"a[0-9].go":    {"a1.go", "a2.go"},
+		"[^a]*.go":     {"b1.go", "c.go"},
+		"a?.go":        {"a1.go", "a2.go"},
+		"a??.go":       {"a10.go"},
+		"?.go":         {"c.go"},
+		"l?b/[x-y].go": {"lib/x.go", "lib/y.go"},
+	}
+
+	for pattern, names := range cases {
+		expected := []string{}
+		for _, name := range names {
+			expected = append(expected, filepath.Join(dir, filepath.FromSlash(name)))
+		}
+
+		if got := expandGlobs([]string{filepath.Join(dir, filepath.FromSlash(pattern))}); !reflect.DeepEqual(got, expected) {
+			t.Errorf("Expected %v for %s got %v", expected, pattern, got)
+		}
+	}
+}
+
+func TestExpandGlobsSymlinks(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+	other, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(other)
+
+	os.MkdirAll(filepath.Join(dir, "src"), 0700)
+	ioutil.WriteFile(filepath.Join(dir, "src", "main.go"), []byte("package main\n"), 0600)
+	ioutil.WriteFile(filepath.Join(other, "docs.go"), []byte("package docs\n"), 0600)
+
+	if err := os.Symlink(other, filepath.Join(dir, "docs")); err != nil {
+		t.Skip("symlinks are not supported")
+	}
+	os.Symlink(filepath.Join(dir, "src", "main.go"), filepath.Join(dir, "link.go"))
+	// Loops back to the root which would never finish if followed naively
+	os.Symlink(dir, filepath.Join(dir, "src", "loop"))
+
+	defer func() { FollowSymlinks = false }()
+
+	FollowSymlinks = false
+	got := expandGlobs([]string{filepath.Join(dir, "**", "*.go")})
+	expected := []string{filepath.Join(dir, "link.go"), filepath.Join(dir, "src", "main.go")}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v got %v", expected, got)
+	}
+
+	FollowSymlinks = true
+	got = expandGlobs([]string{filepath.Join(dir, "**", "*.go")})
+	expected = []string{filepath.Join(dir, "docs", "docs.go"), filepath.Join(dir, "link.go"), filepath.Join(dir, "src", "main.go")}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("Expected %v got %v", expected, got)
+	}
+}
@@ -1,7 +1,11 @@
 package processor
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
 	"time"
+	"unicode"
 )
 
 // Returns the current time as a millisecond timestamp
@@ -29,6 +33,39 @@ func max(a, b int) int {
 	return b
 }
 
+var byteSizeUnits = map[string]float64{
+	"":    1,
+	"b":   1,
+	"k":   1 << 10,
+	"kb":  1 << 10,
+	"kib": 1 << 10,
+	"m":   1 << 20,
+	"mb":  1 << 20,
+	"mib": 1 << 20,
+	"g":   1 << 30,
+	"gb":  1 << 30,
+	"gib": 1 << 30,
+	"t":   1 << 40,
+	"tb":  1 << 40,
+	"tib": 1 << 40,
+}
+
+// ParseByteSize converts a human readable size such as 5MB, 512k or 1.5GB into bytes
+// where the units are case insensitive powers of 1024 and no unit means bytes
+func ParseByteSize(value string) (int64, error) {
+	trimmed := strings.ToLower(strings.TrimSpace(value))
+	number := strings.TrimRightFunc(trimmed, unicode.IsLetter)
+	unit := trimmed[len(number):]
+
+	multiplier, ok := byteSizeUnits[unit]
+	size, err := strconv.ParseFloat(strings.TrimSpace(number), 64)
+	if !ok || err != nil || size < 0 {
+		return 0, fmt.Errorf("invalid size: %s", value)
+	}
+
+	return int64(size * multiplier), nil
+}
+
 // Takes a slice of bytes and returns a unique slice of bytes
 func uniqueByte(slice []byte) []byte {
 	keys := make(map[byte]bool)
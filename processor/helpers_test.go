@@ -39,3 +39,27 @@ func TestMin(t *testing.T) {
 		t.Errorf("Max should be 1")
 	}
 }
+
+func TestParseByteSize(t *testing.T) {
+	for value, expected := range map[string]int64{
+		"0":      0,
+		"512":    512,
+		"100b":   100,
+		"5MB":    5 * 1024 * 1024,
+		"5 mb":   5 * 1024 * 1024,
+		"512k":   512 * 1024,
+		"1.5GiB": 1536 * 1024 * 1024,
+		"2T":     2 * 1024 * 1024 * 1024 * 1024,
+	} {
+		got, err := ParseByteSize(value)
+		if err != nil || got != expected {
+			t.Errorf("Expected %d for %s got %d %v", expected, value, got, err)
+		}
+	}
+
+	for _, value := range []string{"", "MB", "5XB", "-1", "five"} {
+		if _, err := ParseByteSize(value); err == nil {
+			t.Errorf("Expected error for %q", value)
+		}
+	}
+}
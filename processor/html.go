@@ -0,0 +1,123 @@
+package processor
+
+import (
+	"fmt"
+	"html/template"
+	"strings"
+)
+
+// Self contained page with inline CSS and JS so the report works offline
+var htmlTemplate = template.Must(template.New("html").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>scc report</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", Helvetica, Arial, sans-serif; margin: 2em; color: #24292e; }
+table { border-collapse: collapse; width: 100%; }
+th, td { padding: 6px 12px; border-bottom: 1px solid #e1e4e8; text-align: right; }
+th:first-child, td:first-child { text-align: left; }
+th { cursor: pointer; background: #f6f8fa; user-select: none; }
+th.asc::after { content: " \25B2"; }
+th.desc::after { content: " \25BC"; }
+tfoot td { font-weight: bold; border-top: 2px solid #24292e; }
+.card { display: inline-block; margin-top: 2em; padding: 1em 1.5em; border: 1px solid #e1e4e8; border-radius: 6px; background: #f6f8fa; }
+.card dt { font-size: 0.85em; color: #586069; }
+.card dd { margin: 0 0 0.75em 0; font-size: 1.25em; }
+</style>
+</head>
+<body>
+<table id="languages">
+<thead>
+<tr><th>Language</th><th>Files</th><th>Lines</th><th>Code</th><th>Comments</th><th>Blanks</th><th>Complexity</th></tr>
+</thead>
+<tbody>
+{{- range .Languages}}
+<tr><td>{{.Name}}</td><td>{{.Count}}</td><td>{{.Lines}}</td><td>{{.Code}}</td><td>{{.Comment}}</td><td>{{.Blank}}</td><td>{{.Complexity}}</td></tr>
+{{- end}}
+</tbody>
+<tfoot>
+<tr><td>Total</td><td>{{.Total.Files}}</td><td>{{.Total.Lines}}</td><td>{{.Total.Code}}</td><td>{{.Total.Comment}}</td><td>{{.Total.Blank}}</td><td>{{.Total.Complexity}}</td></tr>
+</tfoot>
+</table>
+{{- with .Cocomo}}
+<dl class="card">
+<dt>Estimated Cost to Develop</dt><dd>{{.Cost}}</dd>
+<dt>Estimated Schedule Effort</dt><dd>{{.Schedule}}</dd>
+<dt>Estimated People Required</dt><dd>{{.People}}</dd>
+</dl>
+{{- end}}
+<script>
+(function () {
+	var table = document.getElementById("languages");
+	var headers = table.tHead.rows[0].cells;
+	for (var i = 0; i < headers.length; i++) {
+		headers[i].addEventListener("click", sort.bind(null, i));
+	}
+
+	function sort(column) {
+		var header = headers[column];
+		var ascending = !header.classList.contains("asc");
+		for (var i = 0; i < headers.length; i++) {
+			headers[i].classList.remove("asc", "desc");
+		}
+		header.classList.add(ascending ? "asc" : "desc");
+
+		var body = table.tBodies[0];
+		var rows = Array.prototype.slice.call(body.rows);
+		rows.sort(function (a, b) {
+			var x = a.cells[column].textContent, y = b.cells[column].textContent;
+			var result = column === 0 ? x.localeCompare(y) : Number(x) - Number(y);
+			return ascending ? result : -result;
+		});
+		rows.forEach(function (row) { body.appendChild(row); });
+	}
+})();
+</script>
+</body>
+</html>
+`))
+
+// The COCOMO estimates formatted as they are in the tabular output
+type htmlCocomo struct {
+	Cost     string
+	Schedule string
+	People   string
+}
+
+type htmlReport struct {
+	Languages []LanguageSummary
+	Total     Total
+	Cocomo    *htmlCocomo
+}
+
+func toHtml(input chan *FileJob) string {
+	summary := buildJsonSummary(input)
+
+	report := htmlReport{
+		Languages: summary.Languages,
+		Total:     summary.Total,
+	}
+
+	if !NoCocomo {
+		estimatedEffort := EstimateEffort(weightedCode(summary.Languages))
+		estimatedCost := EstimateCost(estimatedEffort, AverageWage)
+		estimatedScheduleMonths := EstimateScheduleMonths(estimatedEffort)
+
+		report.Cocomo = &htmlCocomo{
+			Cost:     fmt.Sprintf("%s%s", CurrencySymbol, formatThousands(int64(estimatedCost), ThousandsSeparator)),
+			Schedule: fmt.Sprintf("%f months", estimatedScheduleMonths),
+			People:   fmt.Sprintf("%f", estimatedEffort/estimatedScheduleMonths),
+		}
+	}
+
+	startTime := makeTimestampMilli()
+	var str strings.Builder
+	htmlTemplate.Execute(&str, report)
+
+	if Debug {
+		printDebug(fmt.Sprintf("milliseconds to build formatted string: %d", makeTimestampMilli()-startTime))
+	}
+
+	return str.String()
+}
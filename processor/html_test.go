@@ -0,0 +1,39 @@
+package processor
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestToHtml(t *testing.T) {
+	inputChan := make(chan *FileJob, 10)
+	inputChan <- &FileJob{Language: "Go", Lines: 10, Code: 6, Comment: 2, Blank: 2, Complexity: 3}
+	inputChan <- &FileJob{Language: "<b>Java</b>", Lines: 1, Code: 1}
+	close(inputChan)
+
+	html := toHtml(inputChan)
+
+	for _, expected := range []string{
+		"<tr><td>Go</td><td>1</td><td>10</td><td>6</td><td>2</td><td>2</td><td>3</td></tr>",
+		"<td>&lt;b&gt;Java&lt;/b&gt;</td>",
+		"<tr><td>Total</td><td>2</td><td>11</td><td>7</td><td>2</td><td>2</td><td>3</td></tr>",
+		"Estimated Cost to Develop",
+		"<script>",
+	} {
+		if !strings.Contains(html, expected) {
+			t.Errorf("Expected %s in %s", expected, html)
+		}
+	}
+}
+
+func TestToHtmlNoCocomo(t *testing.T) {
+	NoCocomo = true
+	defer func() { NoCocomo = false }()
+
+	inputChan := make(chan *FileJob, 10)
+	close(inputChan)
+
+	if html := toHtml(inputChan); strings.Contains(html, "Estimated Cost to Develop") {
+		t.Errorf("Expected no COCOMO in %s", html)
+	}
+}
@@ -0,0 +1,362 @@
+package processor
+
+import (
+	"context"
+	"reflect"
+	"sync"
+)
+
+// Config holds the settings of a run which are otherwise the package level variables of the same name,
+// which remain as the settings used by the command line and by Process, ProcessContext and ProcessResults.
+// Each field is documented by its variable and the --help of the flag which sets it
+type Config struct {
+	Files                       bool
+	Languages                   bool
+	Verbose                     bool
+	Debug                       bool
+	Trace                       bool
+	Duplicates                  bool
+	ShowSkipped                 bool
+	DuplicateGroups             bool
+	DuplicateHash               string
+	Complexity                  bool
+	Docstrings                  bool
+	Imports                     bool
+	LanguagesFile               string
+	LinesOnly                   bool
+	LineLength                  bool
+	TotalOnly                   bool
+	FilesOnly                   bool
+	Percent                     bool
+	PercentOf                   string
+	MaxFileSize                 int64
+	MmapThreshold               int64
+	More                        bool
+	NoCocomo                    bool
+	CocomoProjectType           string
+	CocomoWeights               map[string]string
+	DisableCheckBinary          bool
+	SortBy                      string
+	Reverse                     bool
+	Exclude                     string
+	ExcludePath                 []string
+	Format                      string
+	FormatTemplate              string
+	FileOutput                  string
+	SQLTable                    string
+	PathBlacklist               []string
+	ExcludeDir                  []string
+	FollowSymlinks              bool
+	FileListQueueSize           int
+	DirectoryWalkJobWorkers     int
+	FileReadJobQueueSize        int
+	FileReadJobWorkers          int
+	FileReadContentJobQueueSize int
+	FileProcessJobQueueSize     int
+	FileProcessJobWorkers       int
+	FileSummaryJobQueueSize     int
+	MaxWorkers                  int
+	WhiteListExtensions         []string
+	ForceLanguage               map[string]string
+	CountAs                     []string
+	CountUnknown                bool
+	NoComplexityLanguages       []string
+	ComplexityTokens            []string
+	GitRef                      string
+	GitTracked                  bool
+	Since                       string
+	PathStyle                   string
+	FilenameWidth               int
+	NoColor                     bool
+	ComplexityWarning           int64
+	ComplexityHotspot           int64
+	Strict                      bool
+	ComplexityMax               int64
+	TotalComplexityMax          int64
+	MinCommentRatio             float64
+	Diff                        bool
+	DryRun                      bool
+	DebugFile                   string
+	AverageWage                 float64
+	CurrencySymbol              string
+	ThousandsSeparator          string
+	GcFileCount                 int
+	MinLines                    int64
+	MaxLines                    int64
+	Minified                    bool
+	NoMinified                  bool
+	MinifiedLineLength          int64
+	NoGen                       bool
+	GeneratedMarkers            []string
+	ULOC                        bool
+	ComplexityHistogram         bool
+	Ratios                      bool
+	Tests                       bool
+	SplitComponents             bool
+	MarkdownFences              bool
+	ByDirectory                 int
+	DirFilePaths                []string
+	OnFileProcessed             func(*FileJob)
+}
+
+// The settings before any have been changed, which are the same as the defaults of the flags
+var defaultConfig = CurrentConfig()
+
+// Held while files are processed so that only one run at a time uses the package level variables, and
+// by the functions for a program using scc as a library which read them so they never see the settings
+// of a run from ProcessContextConfig. Those functions cannot be called from OnFileProcessed as a result
+var processMutex sync.Mutex
+
+// DefaultConfig returns the settings scc uses when no flags are given, which can be changed and
+// given to ProcessContextConfig or ProcessResultsConfig. Slices and maps are copied so that changing them does not change the defaults
+func DefaultConfig() Config {
+	return defaultConfig.clone()
+}
+
+// CurrentConfig returns the settings in the package level variables such as those set by the flags
+func CurrentConfig() Config {
+	return Config{
+		Files:                       Files,
+		Languages:                   Languages,
+		Verbose:                     Verbose,
+		Debug:                       Debug,
+		Trace:                       Trace,
+		Duplicates:                  Duplicates,
+		ShowSkipped:                 ShowSkipped,
+		DuplicateGroups:             DuplicateGroups,
+		DuplicateHash:               DuplicateHash,
+		Complexity:                  Complexity,
+		Docstrings:                  Docstrings,
+		Imports:                     Imports,
+		LanguagesFile:               LanguagesFile,
+		LinesOnly:                   LinesOnly,
+		LineLength:                  LineLength,
+		TotalOnly:                   TotalOnly,
+		FilesOnly:                   FilesOnly,
+		Percent:                     Percent,
+		PercentOf:                   PercentOf,
+		MaxFileSize:                 MaxFileSize,
+		MmapThreshold:               MmapThreshold,
+		More:                        More,
+		NoCocomo:                    NoCocomo,
+		CocomoProjectType:           CocomoProjectType,
+		CocomoWeights:               CocomoWeights,
+		DisableCheckBinary:          DisableCheckBinary,
+		SortBy:                      SortBy,
+		Reverse:                     Reverse,
+		Exclude:                     Exclude,
+		ExcludePath:                 ExcludePath,
+		Format:                      Format,
+		FormatTemplate:              FormatTemplate,
+		FileOutput:                  FileOutput,
+		SQLTable:                    SQLTable,
+		PathBlacklist:               PathBlacklist,
+		ExcludeDir:                  ExcludeDir,
+		FollowSymlinks:              FollowSymlinks,
+		FileListQueueSize:           FileListQueueSize,
+		DirectoryWalkJobWorkers:     DirectoryWalkJobWorkers,
+		FileReadJobQueueSize:        FileReadJobQueueSize,
+		FileReadJobWorkers:          FileReadJobWorkers,
+		FileReadContentJobQueueSize: FileReadContentJobQueueSize,
+		FileProcessJobQueueSize:     FileProcessJobQueueSize,
+		FileProcessJobWorkers:       FileProcessJobWorkers,
+		FileSummaryJobQueueSize:     FileSummaryJobQueueSize,
+		MaxWorkers:                  MaxWorkers,
+		WhiteListExtensions:         WhiteListExtensions,
+		ForceLanguage:               ForceLanguage,
+		CountAs:                     CountAs,
+		CountUnknown:                CountUnknown,
+		NoComplexityLanguages:       NoComplexityLanguages,
+		ComplexityTokens:            ComplexityTokens,
+		GitRef:                      GitRef,
+		GitTracked:                  GitTracked,
+		Since:                       Since,
+		PathStyle:                   PathStyle,
+		FilenameWidth:               FilenameWidth,
+		NoColor:                     NoColor,
+		ComplexityWarning:           ComplexityWarning,
+		ComplexityHotspot:           ComplexityHotspot,
+		Strict:                      Strict,
+		ComplexityMax:               ComplexityMax,
+		TotalComplexityMax:          TotalComplexityMax,
+		MinCommentRatio:             MinCommentRatio,
+		Diff:                        Diff,
+		DryRun:                      DryRun,
+		DebugFile:                   DebugFile,
+		AverageWage:                 AverageWage,
+		CurrencySymbol:              CurrencySymbol,
+		ThousandsSeparator:          ThousandsSeparator,
+		GcFileCount:                 GcFileCount,
+		MinLines:                    MinLines,
+		MaxLines:                    MaxLines,
+		Minified:                    Minified,
+		NoMinified:                  NoMinified,
+		MinifiedLineLength:          MinifiedLineLength,
+		NoGen:                       NoGen,
+		GeneratedMarkers:            GeneratedMarkers,
+		ULOC:                        ULOC,
+		ComplexityHistogram:         ComplexityHistogram,
+		Ratios:                      Ratios,
+		Tests:                       Tests,
+		SplitComponents:             SplitComponents,
+		MarkdownFences:              MarkdownFences,
+		ByDirectory:                 ByDirectory,
+		DirFilePaths:                DirFilePaths,
+		OnFileProcessed:             OnFileProcessed,
+	}
+}
+
+// Sets the package level variables from the config
+func (cfg Config) apply() {
+	Files = cfg.Files
+	Languages = cfg.Languages
+	Verbose = cfg.Verbose
+	Debug = cfg.Debug
+	Trace = cfg.Trace
+	Duplicates = cfg.Duplicates
+	ShowSkipped = cfg.ShowSkipped
+	DuplicateGroups = cfg.DuplicateGroups
+	DuplicateHash = cfg.DuplicateHash
+	Complexity = cfg.Complexity
+	Docstrings = cfg.Docstrings
+	Imports = cfg.Imports
+	LanguagesFile = cfg.LanguagesFile
+	LinesOnly = cfg.LinesOnly
+	LineLength = cfg.LineLength
+	TotalOnly = cfg.TotalOnly
+	FilesOnly = cfg.FilesOnly
+	Percent = cfg.Percent
+	PercentOf = cfg.PercentOf
+	MaxFileSize = cfg.MaxFileSize
+	MmapThreshold = cfg.MmapThreshold
+	More = cfg.More
+	NoCocomo = cfg.NoCocomo
+	CocomoProjectType = cfg.CocomoProjectType
+	CocomoWeights = cfg.CocomoWeights
+	DisableCheckBinary = cfg.DisableCheckBinary
+	SortBy = cfg.SortBy
+	Reverse = cfg.Reverse
+	Exclude = cfg.Exclude
+	ExcludePath = cfg.ExcludePath
+	Format = cfg.Format
+	FormatTemplate = cfg.FormatTemplate
+	FileOutput = cfg.FileOutput
+	SQLTable = cfg.SQLTable
+	PathBlacklist = cfg.PathBlacklist
+	ExcludeDir = cfg.ExcludeDir
+	FollowSymlinks = cfg.FollowSymlinks
+	FileListQueueSize = cfg.FileListQueueSize
+	DirectoryWalkJobWorkers = cfg.DirectoryWalkJobWorkers
+	FileReadJobQueueSize = cfg.FileReadJobQueueSize
+	FileReadJobWorkers = cfg.FileReadJobWorkers
+	FileReadContentJobQueueSize = cfg.FileReadContentJobQueueSize
+	FileProcessJobQueueSize = cfg.FileProcessJobQueueSize
+	FileProcessJobWorkers = cfg.FileProcessJobWorkers
+	FileSummaryJobQueueSize = cfg.FileSummaryJobQueueSize
+	MaxWorkers = cfg.MaxWorkers
+	WhiteListExtensions = cfg.WhiteListExtensions
+	ForceLanguage = cfg.ForceLanguage
+	CountAs = cfg.CountAs
+	CountUnknown = cfg.CountUnknown
+	NoComplexityLanguages = cfg.NoComplexityLanguages
+	ComplexityTokens = cfg.ComplexityTokens
+	GitRef = cfg.GitRef
+	GitTracked = cfg.GitTracked
+	Since = cfg.Since
+	PathStyle = cfg.PathStyle
+	FilenameWidth = cfg.FilenameWidth
+	NoColor = cfg.NoColor
+	ComplexityWarning = cfg.ComplexityWarning
+	ComplexityHotspot = cfg.ComplexityHotspot
+	Strict = cfg.Strict
+	ComplexityMax = cfg.ComplexityMax
+	TotalComplexityMax = cfg.TotalComplexityMax
+	MinCommentRatio = cfg.MinCommentRatio
+	Diff = cfg.Diff
+	DryRun = cfg.DryRun
+	DebugFile = cfg.DebugFile
+	AverageWage = cfg.AverageWage
+	CurrencySymbol = cfg.CurrencySymbol
+	ThousandsSeparator = cfg.ThousandsSeparator
+	GcFileCount = cfg.GcFileCount
+	MinLines = cfg.MinLines
+	MaxLines = cfg.MaxLines
+	Minified = cfg.Minified
+	NoMinified = cfg.NoMinified
+	MinifiedLineLength = cfg.MinifiedLineLength
+	NoGen = cfg.NoGen
+	GeneratedMarkers = cfg.GeneratedMarkers
+	ULOC = cfg.ULOC
+	ComplexityHistogram = cfg.ComplexityHistogram
+	Ratios = cfg.Ratios
+	Tests = cfg.Tests
+	SplitComponents = cfg.SplitComponents
+	MarkdownFences = cfg.MarkdownFences
+	ByDirectory = cfg.ByDirectory
+	DirFilePaths = cfg.DirFilePaths
+	OnFileProcessed = cfg.OnFileProcessed
+}
+
+// Returns a copy of the config which shares no slices or maps with it as processing a run
+// can change them, such as appending to ExcludeDir or lower casing ForceLanguage
+func (cfg Config) clone() Config {
+	value := reflect.ValueOf(&cfg).Elem()
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Field(i)
+
+		switch field.Kind() {
+		case reflect.Slice:
+			if field.IsNil() {
+				continue
+			}
+			field.Set(reflect.AppendSlice(reflect.MakeSlice(field.Type(), 0, field.Len()), field))
+		case reflect.Map:
+			if field.IsNil() {
+				continue
+			}
+			copied := reflect.MakeMap(field.Type())
+			for _, key := range field.MapKeys() {
+				copied.SetMapIndex(key, field.MapIndex(key))
+			}
+			field.Set(copied)
+		}
+	}
+
+	return cfg
+}
+
+// ProcessContextConfig is the same as ProcessContext using the settings of the config rather than the
+// package level variables, such as a config from DefaultConfig with some settings changed. Counting
+// still uses the package level variables so only one run happens at a time, with each waiting for any
+// other to finish before setting them from its config and putting the previous settings back after
+func ProcessContextConfig(ctx context.Context, cfg Config) error {
+	return runConfig(cfg, func() error {
+		return processContext(ctx)
+	})
+}
+
+// ProcessResultsConfig is the same as ProcessResults using the settings of the config rather than
+// the package level variables, which waits for any other run to finish as ProcessContextConfig does
+func ProcessResultsConfig(cfg Config) ([]LanguageSummary, error) {
+	var results []LanguageSummary
+	err := runConfig(cfg, func() error {
+		var err error
+		results, err = processResults()
+		return err
+	})
+
+	return results, err
+}
+
+// Sets the package level variables from a copy of the config for the duration of the function,
+// waiting for any other run to finish first, and puts the previous settings back after
+func runConfig(cfg Config, run func() error) error {
+	processMutex.Lock()
+	defer processMutex.Unlock()
+
+	previous := CurrentConfig()
+	defer previous.apply()
+
+	cfg.clone().apply()
+	return run()
+}
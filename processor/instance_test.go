@@ -0,0 +1,145 @@
+package processor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sync"
+	"testing"
+)
+
+func TestConfigApply(t *testing.T) {
+	original := CurrentConfig()
+	defer original.apply()
+
+	// Each field is changed on its own so a field which is missed or set from the wrong
+	// variable by CurrentConfig or apply shows up as a difference
+	value := reflect.ValueOf(original)
+	for i := 0; i < value.NumField(); i++ {
+		cfg := original.clone()
+		field := reflect.ValueOf(&cfg).Elem().Field(i)
+
+		switch field.Kind() {
+		case reflect.Bool:
+			field.SetBool(!field.Bool())
+		case reflect.String:
+			field.SetString(field.String() + "changed")
+		case reflect.Int, reflect.Int64:
+			field.SetInt(field.Int() + 7)
+		case reflect.Float64:
+			field.SetFloat(field.Float() + 7)
+		case reflect.Slice:
+			field.Set(reflect.Append(field, reflect.ValueOf("changed")))
+		case reflect.Map:
+			field.Set(reflect.ValueOf(map[string]string{"changed": "changed"}))
+		case reflect.Func:
+			field.Set(reflect.ValueOf(func(*FileJob) {}))
+		default:
+			t.Fatalf("Unexpected kind %s for %s", field.Kind(), value.Type().Field(i).Name)
+		}
+
+		cfg.apply()
+		got := CurrentConfig()
+
+		name := value.Type().Field(i).Name
+		if field.Kind() == reflect.Func {
+			if reflect.ValueOf(got).Field(i).IsNil() {
+				t.Errorf("Expected %s to be applied", name)
+			}
+			got.OnFileProcessed, cfg.OnFileProcessed = nil, nil
+		}
+
+		if !reflect.DeepEqual(got, cfg) {
+			t.Errorf("Expected %s to be applied on its own got %+v", name, got)
+		}
+
+		original.apply()
+	}
+}
+
+func TestDefaultConfig(t *testing.T) {
+	cfg := DefaultConfig()
+	if cfg.Format != "tabular" || cfg.SortBy != "files" || len(cfg.ExcludeDir) != 3 || cfg.SQLTable != "t" || cfg.MinifiedLineLength != 255 || len(cfg.GeneratedMarkers) == 0 {
+		t.Errorf("Unexpected defaults %+v", cfg)
+	}
+
+	cfg.GeneratedMarkers[0] = "changed"
+	cfg.CocomoWeights["Go"] = "2"
+	if other := DefaultConfig(); other.GeneratedMarkers[0] == "changed" || len(other.CocomoWeights) != 0 {
+		t.Errorf("Expected the defaults to be copied got %+v", other)
+	}
+}
+
+// Run using go test -race -run TestProcessConfigGoroutines to check runs do not race on the settings
+func TestProcessConfigGoroutines(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	ioutil.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), 0600)
+	ioutil.WriteFile(filepath.Join(dir, "Main.java"), []byte("class Main {}\n"), 0600)
+
+	before := CurrentConfig()
+
+	goConfig := DefaultConfig()
+	goConfig.DirFilePaths = []string{dir}
+	goConfig.WhiteListExtensions = []string{"go"}
+
+	javaConfig := DefaultConfig()
+	javaConfig.DirFilePaths = []string{dir}
+	javaConfig.WhiteListExtensions = []string{"java"}
+
+	configs := map[string]Config{"Go": goConfig, "Java": javaConfig}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		for language, cfg := range configs {
+			wg.Add(1)
+			go func(language string, cfg Config) {
+				defer wg.Done()
+
+				results, err := ProcessResultsConfig(cfg)
+				if err != nil {
+					t.Errorf("Expected no error got %s", err)
+					return
+				}
+
+				if len(results) != 1 || results[0].Name != language {
+					t.Errorf("Expected only %s got %+v", language, results)
+				}
+			}(language, cfg)
+		}
+	}
+
+	// The helpers for a program using scc as a library wait for the runs rather than seeing their settings
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if extensions := LanguageExtensions("Go"); len(extensions) == 0 {
+				t.Error("Expected extensions for Go")
+			}
+		}()
+	}
+	wg.Wait()
+
+	if after := CurrentConfig(); !reflect.DeepEqual(after.DirFilePaths, before.DirFilePaths) || !reflect.DeepEqual(after.WhiteListExtensions, before.WhiteListExtensions) {
+		t.Errorf("Expected the package level settings to be put back got %v %v", after.DirFilePaths, after.WhiteListExtensions)
+	}
+}
+
+func TestProcessConfigInvalid(t *testing.T) {
+	cfg := DefaultConfig()
+	cfg.DirFilePaths = []string{"."}
+	cfg.DuplicateHash = "nope"
+
+	results, err := ProcessResultsConfig(cfg)
+	if err == nil || err.Error() != "unknown duplicate hash: nope" {
+		t.Errorf("Expected an unknown duplicate hash error got %v", err)
+	}
+
+	if results != nil {
+		t.Errorf("Expected no results got %+v", results)
+	}
+}
@@ -0,0 +1,103 @@
+package processor
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+)
+
+// The language notebooks are identified as by their .ipynb extension
+const JupyterLanguage = "Jupyter"
+
+// The language of the code cells when the notebook does not say which kernel it uses
+const defaultNotebookLanguage = "Python"
+
+// The parts of a Jupyter notebook which are needed to count it
+type notebook struct {
+	Metadata struct {
+		Kernelspec struct {
+			Language string `json:"language"`
+		} `json:"kernelspec"`
+		LanguageInfo struct {
+			Name string `json:"name"`
+		} `json:"language_info"`
+	} `json:"metadata"`
+	Cells []notebookCell `json:"cells"`
+}
+
+type notebookCell struct {
+	CellType string          `json:"cell_type"`
+	Source   json.RawMessage `json:"source"`
+}
+
+// The source of the cell which is either a string or a list of lines which each end with a newline
+func (c notebookCell) source() string {
+	var lines []string
+	if err := json.Unmarshal(c.Source, &lines); err == nil {
+		return strings.Join(lines, "")
+	}
+
+	var source string
+	json.Unmarshal(c.Source, &source)
+	return source
+}
+
+// Returns the name the language of the kernel of the notebook has in the database,
+// falling back to Python which is what most notebooks use
+func notebookLanguage(nb notebook) string {
+	for _, name := range []string{nb.Metadata.Kernelspec.Language, nb.Metadata.LanguageInfo.Name} {
+		for language := range LanguageFeatures {
+			if name != "" && strings.EqualFold(name, language) {
+				return language
+			}
+		}
+	}
+
+	return defaultNotebookLanguage
+}
+
+// Counts a Jupyter notebook by its cells rather than the JSON it is stored as. The code cells are
+// counted as the language of the kernel and the lines of the markdown cells as comments or blanks.
+// Anything which is not a notebook in the version 4 format is counted as it is
+func countNotebook(fileJob *FileJob) {
+	var nb notebook
+	if err := json.Unmarshal(fileJob.Content, &nb); err != nil || nb.Cells == nil {
+		CountStats(fileJob)
+		return
+	}
+
+	var code bytes.Buffer
+	var lines, comment, blank int64
+	for _, cell := range nb.Cells {
+		source := cell.source()
+		if source == "" {
+			continue
+		}
+
+		switch cell.CellType {
+		case "code":
+			code.WriteString(source)
+			if !strings.HasSuffix(source, "\n") {
+				code.WriteByte('\n')
+			}
+		case "markdown":
+			for _, line := range strings.Split(strings.TrimSuffix(source, "\n"), "\n") {
+				lines++
+				if strings.TrimSpace(line) == "" {
+					blank++
+				} else {
+					comment++
+				}
+			}
+		}
+	}
+
+	fileJob.Content = code.Bytes()
+	fileJob.Language = notebookLanguage(nb)
+	CountStats(fileJob)
+	fileJob.Language = JupyterLanguage
+
+	fileJob.Lines += lines
+	fileJob.Comment += comment
+	fileJob.Blank += blank
+}
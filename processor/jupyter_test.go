@@ -0,0 +1,58 @@
+package processor
+
+import (
+	"testing"
+)
+
+func TestCountNotebook(t *testing.T) {
+	ProcessConstants()
+
+	content := []byte(`{
+ "cells": [
+  {"cell_type": "markdown", "metadata": {}, "source": ["# Title\n", "\n", "Some text"]},
+  {"cell_type": "code", "metadata": {}, "outputs": [], "source": ["import os\n", "# comment\n", "if x:\n", "    print(1)"]},
+  {"cell_type": "code", "metadata": {}, "outputs": [], "source": "\nprint(2)\n"},
+  {"cell_type": "raw", "metadata": {}, "source": ["ignored"]}
+ ],
+ "metadata": {"kernelspec": {"display_name": "Python 3", "language": "python", "name": "python3"}},
+ "nbformat": 4,
+ "nbformat_minor": 2
+}`)
+
+	fileJob := FileJob{Language: JupyterLanguage, Content: content}
+	countNotebook(&fileJob)
+
+	if fileJob.Language != JupyterLanguage {
+		t.Errorf("Expected %s got %s", JupyterLanguage, fileJob.Language)
+	}
+
+	// Three markdown lines, four lines in the first code cell and two in the second
+	if fileJob.Lines != 9 || fileJob.Code != 4 || fileJob.Comment != 3 || fileJob.Blank != 2 || fileJob.Complexity != 1 {
+		t.Errorf("Expected 9 lines 4 code 3 comment 2 blank 1 complexity got %d %d %d %d %d", fileJob.Lines, fileJob.Code, fileJob.Comment, fileJob.Blank, fileJob.Complexity)
+	}
+}
+
+func TestCountNotebookInvalid(t *testing.T) {
+	ProcessConstants()
+
+	fileJob := FileJob{Language: JupyterLanguage, Content: []byte("{\n\"a\": 1\n")}
+	countNotebook(&fileJob)
+
+	if fileJob.Lines != 2 || fileJob.Code != 2 {
+		t.Errorf("Expected invalid notebook to be counted as is got %d %d", fileJob.Lines, fileJob.Code)
+	}
+}
+
+func TestNotebookLanguage(t *testing.T) {
+	ProcessConstants()
+
+	var nb notebook
+	if got := notebookLanguage(nb); got != "Python" {
+		t.Errorf("Expected Python got %s", got)
+	}
+
+	nb.Metadata.LanguageInfo.Name = "r"
+	if got := notebookLanguage(nb); got != "R" {
+		t.Errorf("Expected R got %s", got)
+	}
+}
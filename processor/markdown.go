@@ -0,0 +1,96 @@
+package processor
+
+import (
+	"bytes"
+	"strings"
+)
+
+// The language of Markdown files which have their fenced code blocks counted when MarkdownFences is set
+const markdownLanguage = "Markdown"
+
+// Returns the language of a fenced code block from the first word of its info string, such as
+// go or python, which is matched against the language names and then their extensions
+func fenceLanguage(info string) (string, bool) {
+	fields := strings.Fields(info)
+	if len(fields) == 0 {
+		return "", false
+	}
+	name := strings.Trim(fields[0], "{}.")
+
+	for language := range LanguageFeatures {
+		if strings.EqualFold(name, language) {
+			return language, true
+		}
+	}
+
+	return extensionLanguage(strings.ToLower(name))
+}
+
+// Returns the fence of the line if it opens or closes a fenced code block, which is three or
+// more backticks or tildes indented by no more than three spaces, along with what follows it
+func markdownFence(line []byte) ([]byte, []byte, bool) {
+	trimmed := bytes.TrimLeft(line, " ")
+	if len(line)-len(trimmed) > 3 || len(trimmed) < 3 || (trimmed[0] != '`' && trimmed[0] != '~') {
+		return nil, nil, false
+	}
+
+	length := 0
+	for length < len(trimmed) && trimmed[length] == trimmed[0] {
+		length++
+	}
+	if length < 3 {
+		return nil, nil, false
+	}
+
+	return trimmed[:length], trimmed[length:], true
+}
+
+// Splits Markdown into the prose, including the fences and any blocks whose language is unknown,
+// and the code of the fenced blocks of each language. The order is the prose and then each language
+// in the order it first appears where all the blocks of a language are counted together
+func splitMarkdown(content []byte) ([]string, [][]byte) {
+	languages := []string{markdownLanguage}
+	sections := [][]byte{{}}
+	index := map[string]int{markdownLanguage: 0}
+
+	var fence []byte
+	current := 0
+
+	for len(content) != 0 {
+		end := bytes.IndexByte(content, '\n') + 1
+		if end == 0 {
+			end = len(content)
+		}
+		line := content[:end]
+		content = content[end:]
+
+		trimmed := bytes.TrimRight(line, "\r\n")
+		if marker, rest, ok := markdownFence(trimmed); ok {
+			switch {
+			case fence == nil && (marker[0] == '~' || bytes.IndexByte(rest, '`') == -1):
+				fence, current = marker, 0
+				if language, ok := fenceLanguage(string(rest)); ok {
+					if _, ok := index[language]; !ok {
+						index[language] = len(languages)
+						languages = append(languages, language)
+						sections = append(sections, []byte{})
+					}
+					current = index[language]
+				}
+				sections[0] = append(sections[0], line...)
+				continue
+			case fence != nil && marker[0] == fence[0] && len(marker) >= len(fence) && len(bytes.TrimSpace(rest)) == 0:
+				fence, current = nil, 0
+				sections[0] = append(sections[0], line...)
+				continue
+			}
+		}
+
+		sections[current] = append(sections[current], line...)
+		if current != 0 && !bytes.HasSuffix(line, []byte("\n")) {
+			sections[current] = append(sections[current], '\n')
+		}
+	}
+
+	return languages, sections
+}
@@ -0,0 +1,52 @@
+package processor
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitMarkdown(t *testing.T) {
+	ProcessConstants()
+
+	content := []byte("# Title\n\n```go\nfunc main() {}\n```\n\nSome text\n\n~~~~python title=x\nprint(1)\n```\nprint(2)\n~~~~\n\n```\nunknown\n```\n``` js\nlet x = 1\n```\n```golang\nfmt.Println()\n```\n")
+
+	languages, sections := splitMarkdown(content)
+
+	expectedLanguages := []string{"Markdown", "Go", "Python", "JavaScript"}
+	if !reflect.DeepEqual(languages, expectedLanguages) {
+		t.Errorf("Expected %v got %v", expectedLanguages, languages)
+	}
+
+	expectedSections := []string{
+		"# Title\n\n```go\n```\n\nSome text\n\n~~~~python title=x\n~~~~\n\n```\nunknown\n```\n``` js\n```\n```golang\nfmt.Println()\n```\n",
+		"func main() {}\n",
+		"print(1)\n```\nprint(2)\n",
+		"let x = 1\n",
+	}
+	got := []string{}
+	for _, section := range sections {
+		got = append(got, string(section))
+	}
+	if !reflect.DeepEqual(got, expectedSections) {
+		t.Errorf("Expected %q got %q", expectedSections, got)
+	}
+}
+
+func TestEmbeddedJobsMarkdown(t *testing.T) {
+	ProcessConstants()
+
+	MarkdownFences = true
+	defer func() { MarkdownFences = false }()
+
+	fileJob := &FileJob{Language: "Markdown", Location: "README.md", Content: []byte("Text\n```go\nfunc main() {\n}\n```")}
+	jobs := embeddedJobs(fileJob)
+
+	if len(jobs) != 2 || jobs[0].Language != "Markdown" || jobs[1].Language != "Go" {
+		t.Fatalf("Expected Markdown and Go jobs got %v", jobs)
+	}
+
+	CountStats(jobs[1])
+	if jobs[1].Code != 2 || jobs[1].Location != "README.md" {
+		t.Errorf("Expected 2 lines of Go code in README.md got %d %s", jobs[1].Code, jobs[1].Location)
+	}
+}
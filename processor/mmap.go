@@ -0,0 +1,20 @@
+//go:build darwin || dragonfly || freebsd || linux || openbsd || solaris || netbsd || windows
+// +build darwin dragonfly freebsd linux openbsd solaris netbsd windows
+
+package processor
+
+import (
+	"os"
+
+	mmapgo "github.com/edsrzf/mmap-go"
+)
+
+// Maps the file read only returning its content and the function to unmap it
+func mapFile(file *os.File) ([]byte, func() error, error) {
+	mapped, err := mmapgo.Map(file, mmapgo.RDONLY, 0)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return mapped, mapped.Unmap, nil
+}
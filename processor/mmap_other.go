@@ -0,0 +1,14 @@
+//go:build !darwin && !dragonfly && !freebsd && !linux && !openbsd && !solaris && !netbsd && !windows
+// +build !darwin,!dragonfly,!freebsd,!linux,!openbsd,!solaris,!netbsd,!windows
+
+package processor
+
+import (
+	"errors"
+	"os"
+)
+
+// Platforms without mmap always fall back to reading the file
+func mapFile(file *os.File) ([]byte, func() error, error) {
+	return nil, nil, errors.New("mmap is not supported on this platform")
+}
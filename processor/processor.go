@@ -1,14 +1,21 @@
 package processor
 
 import (
+	"compress/gzip"
+	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
+	"os"
+	"path/filepath"
 	"runtime"
 	"runtime/debug"
 	"sort"
 	"strings"
+	"sync"
 )
 
 // Flags set via the CLI which control how the output is displayed
@@ -18,50 +25,181 @@ var Verbose = false
 var Debug = false
 var Trace = false
 var Duplicates = false
+var ShowSkipped = false
+var DuplicateGroups = false
+var DuplicateHash = "md5"
 var Complexity = false
+var Docstrings = false
+var Imports = false
+var LanguagesFile = ""
+var LinesOnly = false
+var LineLength = false
+var TotalOnly = false
+var FilesOnly = false
+var Percent = false
+var PercentOf = PercentCode
+
+// Files larger than this many bytes are skipped, 0 means there is no limit
+var MaxFileSize int64 = 0
+
+// Files of at least this many bytes are memory mapped rather than read onto the heap, 0 disables
+var MmapThreshold int64 = 100 * 1024 * 1024
+
 var More = false
-var Cocomo = false
+var NoCocomo = false
+var CocomoProjectType = "organic"
+var CocomoWeights = map[string]string{}
 var DisableCheckBinary = false
-var SortBy = ""
+var SortBy = "files"
+var Reverse = false
 var Exclude = ""
-var Format = ""
+var ExcludePath = []string{}
+var Format = "tabular"
+var FormatTemplate = ""
 var FileOutput = ""
+var SQLTable = "t"
 var PathBlacklist = []string{}
+var ExcludeDir = []string{".git", ".hg", ".svn"}
+var FollowSymlinks = false
 var FileListQueueSize = runtime.NumCPU()
+var DirectoryWalkJobWorkers = runtime.NumCPU() * 4
 var FileReadJobQueueSize = runtime.NumCPU()
 var FileReadJobWorkers = runtime.NumCPU() * 4
 var FileReadContentJobQueueSize = runtime.NumCPU()
 var FileProcessJobQueueSize = runtime.NumCPU()
 var FileProcessJobWorkers = runtime.NumCPU() * 4
 var FileSummaryJobQueueSize = runtime.NumCPU()
+var MaxWorkers = 0
 var WhiteListExtensions = []string{}
-var AverageWage int64 = 56286
+var ForceLanguage = map[string]string{}
+var CountAs = []string{}
+var CountUnknown = false
+
+// Languages which have no complexity counted such as configuration languages with keywords
+var NoComplexityLanguages = []string{}
+
+// Extra complexity checks added to a language for this run each written as language:token
+var ComplexityTokens = []string{}
+var GitRef = ""
+var GitTracked = false
+
+// Whether the run lists the files using git which is GitTracked unless a path is not in a repository
+var gitTracked = false
+
+// Only counts the lines git blame says were changed after this date when set
+var Since = ""
+var PathStyle = ""
+var FilenameWidth = 0
+var NoColor = false
+var Strict = false
+var ComplexityMax int64 = 0
+var TotalComplexityMax int64 = 0
+var MinCommentRatio float64 = 0
+var Diff = false
+var DryRun = false
+var ConfigFile = ""
+var DebugFile = ""
+var CpuProfile = ""
+var MemProfile = ""
+var AverageWage float64 = 56286
+var CurrencySymbol = "$"
+var ThousandsSeparator = ","
+
+// The GC is turned off by ConfigureGc and turned back on once this many files have been read
+// however they were found, which avoids collections when counting small trees. GcAuto leaves it
+// on throughout
 var GcFileCount = 10000
+var GcAuto = false
+var MinLines int64 = 0
+var MaxLines int64 = 0
+var Minified = false
+var NoMinified = false
+var MinifiedLineLength int64 = 255
+var NoGen = false
+
+// Markers which identify a generated file when found near the top of it, matched ignoring case
+var GeneratedMarkers = []string{"do not edit", "@generated", "<auto-generated", "autogenerated by", "automatically generated"}
+var ULOC = false
+var ComplexityHistogram = false
+var Ratios = false
+var Tests = false
+var SplitComponents = false
+var MarkdownFences = false
+
+// Sums the files under each directory this many levels deep, 0 disables
+var ByDirectory = 0
 var gcPercent = -1
 
 // Not set via flags but by arguments following the the flags
 var DirFilePaths = []string{}
 
+// OnFileProcessed is called by Process, ProcessContext and ProcessResults with each file once it has been
+// counted and before it is added to the summary. It is always called from a single goroutine
+// so it does not need to be safe for concurrent use, but processing waits on it so it should
+// return quickly. The content of the file has been released by then. Nil disables it
+var OnFileProcessed func(*FileJob)
+
 // Loaded from the JSON that is in constants.go
 var ExtensionToLanguage = map[string]string{}
+var FileNameToLanguage = map[string]string{}
+var SheBangToLanguage = map[string]string{}
 var LanguageFeatures = map[string]LanguageFeature{}
 
+// Guards the maps loaded by ProcessConstants so that it can be called from multiple goroutines by a
+// program using scc as a library. Files are counted without taking it as that is the hot path, which
+// is safe as ProcessConstants waits on processMutex for any run to finish before changing them
+var constantsMutex sync.RWMutex
+
 // This needs to be set outside of ProcessConstants because it should only be enabled in command line
 // mode https://github.com/boyter/scc/issues/32
 func ConfigureGc() {
+	if GcAuto {
+		return
+	}
+
 	gcPercent = debug.SetGCPercent(gcPercent)
 }
 
 // ProcessConstants is responsible for setting up the language features based on the JSON file that is stored in constants
-// Needs to be called at least once in order for anything to actually happen
-func ProcessConstants() {
-	var database = loadDatabase()
+// Needs to be called at least once in order for anything to actually happen. Returns an error rather than panicking
+// if the languages cannot be loaded, such as a corrupt build or an invalid LanguagesFile, so it can be handled.
+// It is safe to call more than once and from multiple goroutines as the maps are rebuilt each time while holding
+// constantsMutex, so calling it again after changing a setting such as LanguagesFile replaces the languages.
+// It waits for any run to finish as the languages depend on settings such as Complexity
+func ProcessConstants() error {
+	processMutex.Lock()
+	defer processMutex.Unlock()
+
+	return processConstants()
+}
+
+func processConstants() error {
+	database, err := loadDatabase()
+	if err != nil {
+		return err
+	}
+
+	constantsMutex.Lock()
+	defer constantsMutex.Unlock()
+
+	ExtensionToLanguage = map[string]string{}
+	FileNameToLanguage = map[string]string{}
+	SheBangToLanguage = map[string]string{}
+	LanguageFeatures = map[string]LanguageFeature{}
 
 	startTime := makeTimestampNano()
 	for name, value := range database {
 		for _, ext := range value.Extensions {
 			ExtensionToLanguage[ext] = name
 		}
+
+		for _, fileName := range value.FileNames {
+			FileNameToLanguage[strings.ToLower(fileName)] = name
+		}
+
+		for _, interpreter := range value.SheBangs {
+			SheBangToLanguage[interpreter] = name
+		}
 	}
 
 	if Trace {
@@ -82,14 +220,16 @@ func ProcessConstants() {
 		stringMask := byte(0)
 		processMask := byte(0)
 
-		for _, v := range value.ComplexityChecks {
+		countComplexity := !Complexity && !isNoComplexityLanguage(name)
+		complexityChecks := append(append([]string{}, value.ComplexityChecks...), complexityTokens(name)...)
+		for _, v := range complexityChecks {
 			complexityMask |= v[0]
 			complexityTrie.Insert(T_COMPLEXITY, []byte(v))
-			if !Complexity {
+			if countComplexity {
 				tokenTrie.Insert(T_COMPLEXITY, []byte(v))
 			}
 		}
-		if !Complexity {
+		if countComplexity {
 			processMask |= complexityMask
 		}
 
@@ -112,15 +252,61 @@ func ProcessConstants() {
 			stringTrie.InsertClose(T_STRING, []byte(v[0]), []byte(v[1]))
 			tokenTrie.InsertClose(T_STRING, []byte(v[0]), []byte(v[1]))
 		}
+
+		// A prefix on any of the quotes makes a verbatim string where backslash does not escape
+		for _, prefix := range value.VerbatimPrefixes {
+			stringMask |= prefix[0]
+			for _, v := range value.Quotes {
+				tokenTrie.InsertClose(T_VERBATIM, []byte(prefix+v[0]), []byte(v[1]))
+			}
+		}
+
+		// The character which keeps the quote after it from ending a verbatim string
+		verbatimEscape := byte(0)
+		if value.VerbatimEscape != "" {
+			verbatimEscape = value.VerbatimEscape[0]
+		}
+
+		// Raw strings such as R"delim(...)delim" in C++ end with a delimiter read from the file
+		for _, prefix := range value.RawPrefixes {
+			stringMask |= prefix[0]
+			tokenTrie.Insert(T_RAWSTRING, []byte(prefix+`"`))
+		}
 		processMask |= stringMask
 
+		docstrings := [][]byte{}
+		for _, v := range value.Docstrings {
+			docstrings = append(docstrings, []byte(v))
+		}
+
+		imports := [][]byte{}
+		for _, v := range value.Imports {
+			imports = append(imports, []byte(v))
+		}
+
+		importBlocks := []OpenClose{}
+		for _, v := range value.ImportBlocks {
+			importBlocks = append(importBlocks, OpenClose{Open: []byte(v[0]), Close: []byte(v[1])})
+		}
+
+		// The delimiter which ends a heredoc is read from the file so only the marker goes in the trie
+		for _, v := range value.Heredocs {
+			processMask |= v[0]
+			tokenTrie.Insert(T_HEREDOC, []byte(v))
+		}
+
 		LanguageFeatures[name] = LanguageFeature{
 			Complexity:            complexityTrie,
 			MultiLineComments:     mlCommentTrie,
 			SingleLineComments:    slCommentTrie,
 			Strings:               stringTrie,
 			Tokens:                tokenTrie,
+			Docstrings:            docstrings,
+			Imports:               imports,
+			ImportBlocks:          importBlocks,
+			TestFiles:             value.TestFiles,
 			Nested:                value.NestedMultiLine,
+			VerbatimEscape:        verbatimEscape,
 			ComplexityCheckMask:   complexityMask,
 			MultiLineCommentMask:  multiLineCommentMask,
 			SingleLineCommentMask: singleLineCommentMask,
@@ -132,51 +318,464 @@ func ProcessConstants() {
 	if Trace {
 		printTrace(fmt.Sprintf("milliseconds build language features: %d", makeTimestampMilli()-startTime))
 	}
+
+	return nil
+}
+
+// Returns true if the language is in NoComplexityLanguages, which is matched ignoring case
+func isNoComplexityLanguage(name string) bool {
+	for _, language := range NoComplexityLanguages {
+		if strings.EqualFold(strings.TrimSpace(language), name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns the tokens of ComplexityTokens for the language, which is matched ignoring case.
+// Any which are not language:token are left for processFlags to report
+func complexityTokens(name string) []string {
+	tokens := []string{}
+	for _, value := range ComplexityTokens {
+		parts := strings.SplitN(value, ":", 2)
+		if len(parts) == 2 && parts[1] != "" && strings.EqualFold(strings.TrimSpace(parts[0]), name) {
+			tokens = append(tokens, parts[1])
+		}
+	}
+
+	return tokens
+}
+
+// Returns true if the name is a known language, which is matched ignoring case
+func isLanguage(name string) bool {
+	for language := range LanguageFeatures {
+		if strings.EqualFold(strings.TrimSpace(name), language) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GetLanguageFeature returns the features used to count the named language such as
+// its comment and string tokens, processing the language database if required.
+// Nothing is found if the language database cannot be processed
+func GetLanguageFeature(name string) (LanguageFeature, bool) {
+	processMutex.Lock()
+	defer processMutex.Unlock()
+
+	if err := loadConstants(); err != nil {
+		return LanguageFeature{}, false
+	}
+
+	constantsMutex.RLock()
+	defer constantsMutex.RUnlock()
+
+	feature, ok := LanguageFeatures[name]
+	return feature, ok
+}
+
+// LanguageExtensions returns the sorted file extensions of the named language
+// which is empty if it is unknown or the language database cannot be loaded.
+// They come from the language database so an extension shared with another
+// language, such as tex, is included even though files with it are counted as one
+func LanguageExtensions(name string) []string {
+	processMutex.Lock()
+	defer processMutex.Unlock()
+
+	database, err := loadDatabase()
+	if err != nil {
+		return []string{}
+	}
+
+	extensions := append([]string{}, database[name].Extensions...)
+	sort.Strings(extensions)
+
+	return extensions
+}
+
+// Processes the language database unless it has already been for the functions used by a
+// program using scc as a library which do not need ProcessConstants to be called first
+func loadConstants() error {
+	constantsMutex.RLock()
+	loaded := len(LanguageFeatures) != 0
+	constantsMutex.RUnlock()
+
+	if loaded {
+		return nil
+	}
+
+	return processConstants()
 }
 
-func processFlags() {
+// CountBytes counts the content as the named language without it having to be a file, such as an
+// unsaved buffer in an editor, processing the language database if required. The content is counted
+// the same way as a file and a language which is unknown, or every language if the language
+// database cannot be processed, is counted as only code and blank lines
+func CountBytes(name string, content []byte) FileJob {
+	processMutex.Lock()
+	defer processMutex.Unlock()
+
+	// Without the language database the content is still counted as an unknown language
+	_ = loadConstants()
+
+	constantsMutex.RLock()
+	defer constantsMutex.RUnlock()
+
+	fileJob := FileJob{Language: name, Content: content}
+	if name == JupyterLanguage {
+		countNotebook(&fileJob)
+	} else {
+		CountStats(&fileJob)
+	}
+	fileJob.Content = content
+
+	return fileJob
+}
+
+func processFlags() error {
 	// If wide/more mode is enabled we want the complexity calculation
 	// to happen regardless as thats the only purpose of the flag
 	if More && Complexity {
 		Complexity = false
 	}
 
+	// Cap the number of workers for slow disks or machines with many cores where
+	// the defaults based on the number of CPUs would thrash the disk
+	if MaxWorkers > 0 {
+		DirectoryWalkJobWorkers = min(DirectoryWalkJobWorkers, MaxWorkers)
+		FileReadJobWorkers = min(FileReadJobWorkers, MaxWorkers)
+		FileProcessJobWorkers = min(FileProcessJobWorkers, MaxWorkers)
+	}
+
+	// Groups can only be found when duplicates are being detected
+	if DuplicateGroups {
+		Duplicates = true
+	}
+
+	DuplicateHash = strings.ToLower(DuplicateHash)
+	if _, ok := duplicateHashes[DuplicateHash]; !ok {
+		return fmt.Errorf("unknown duplicate hash: %s", DuplicateHash)
+	}
+
+	PathStyle = strings.ToLower(PathStyle)
+	if PathStyle != "" && PathStyle != PathRelative && PathStyle != PathAbsolute {
+		return fmt.Errorf("unknown path style: %s", PathStyle)
+	}
+
+	if Minified && NoMinified {
+		return errors.New("--minified and --no-minified cannot be used together")
+	}
+
+	if TotalOnly {
+		switch strings.ToLower(Format) {
+		case "", "tabular", "wide", "json", "yaml":
+		default:
+			return errors.New("--total-only can only be used with the tabular, wide, json and yaml formats")
+		}
+
+		if LinesOnly {
+			return errors.New("--total-only cannot be used with --lines-only")
+		}
+	}
+
+	if FilesOnly {
+		switch strings.ToLower(Format) {
+		case "json", "ndjson":
+		default:
+			return errors.New("--files-only can only be used with the json and ndjson formats")
+		}
+	}
+
+	PercentOf = strings.ToLower(PercentOf)
+	if PercentOf != PercentCode && PercentOf != PercentLines {
+		return fmt.Errorf("unknown percent of: %s", PercentOf)
+	}
+
+	if MinCommentRatio < 0 {
+		return errors.New("--min-comment-ratio cannot be negative")
+	}
+
+	if LinesOnly && MinCommentRatio > 0 {
+		return errors.New("--min-comment-ratio cannot be used with --lines-only as comments are not counted")
+	}
+
+	if MinCommentRatio > 0 && (TotalOnly || FilesOnly || strings.ToLower(Format) == "ndjson" || strings.ToLower(Format) == "wc") {
+		return errors.New("--min-comment-ratio cannot be used with --total-only, --files-only or the ndjson and wc formats as languages are not summarised")
+	}
+
+	if ByDirectory < 0 {
+		return errors.New("--by-directory must be at least 1")
+	}
+
+	if LinesOnly && (ULOC || ComplexityHistogram) {
+		return errors.New("--lines-only cannot be used with --uloc or --complexity-histogram as code is not counted")
+	}
+
+	force, err := parseForceLanguage(ForceLanguage)
+	if err != nil {
+		return err
+	}
+	ForceLanguage = force
+
+	for _, language := range NoComplexityLanguages {
+		if !isLanguage(language) {
+			return fmt.Errorf("unknown language %s for --no-complexity-language", language)
+		}
+	}
+
+	for _, value := range ComplexityTokens {
+		parts := strings.SplitN(value, ":", 2)
+		if len(parts) != 2 || parts[1] == "" {
+			return fmt.Errorf("invalid --complexity-token %s expected language:token", value)
+		}
+
+		if !isLanguage(parts[0]) {
+			return fmt.Errorf("unknown language %s for --complexity-token", parts[0])
+		}
+	}
+
+	countAs, err := parseCountAs(CountAs)
+	if err != nil {
+		return err
+	}
+	countAsLanguage = countAs
+
+	formatTemplate = nil
+	if FormatTemplate != "" {
+		tmpl, err := parseFormatTemplate(FormatTemplate)
+		if err != nil {
+			return fmt.Errorf("unable to parse format template: %s", err)
+		}
+		formatTemplate = tmpl
+	}
+
+	if GitRef != "" {
+		if err := checkGitRef(GitRef); err != nil {
+			return err
+		}
+	}
+
+	if Since != "" {
+		since, err := parseSince(Since)
+		if err == nil {
+			err = checkSince()
+		}
+		if err != nil {
+			return err
+		}
+		sinceTime = since
+	}
+
+	gitTracked = GitTracked
+	if gitTracked {
+		for _, location := range DirFilePaths {
+			if dir, _ := gitPathspec(location); !isGitRepository(dir) {
+				printWarn(fmt.Sprintf("--git-tracked requires a git repository but %s is not in one, walking the files instead", location))
+				gitTracked = false
+				break
+			}
+		}
+	}
+
+	regexes, err := compileExcludePaths(ExcludePath)
+	if err != nil {
+		return err
+	}
+	excludePathRegexes = regexes
+
+	params, err := parseCocomoProjectType(CocomoProjectType)
+	if err != nil {
+		return err
+	}
+	CocomoProject = params
+
+	weights, err := parseCocomoWeights(CocomoWeights)
+	if err != nil {
+		return err
+	}
+	cocomoWeights = weights
+
 	if Debug {
 		printDebug(fmt.Sprintf("Path Black List: %v", PathBlacklist))
+		printDebug(fmt.Sprintf("Exclude Dir: %v", ExcludeDir))
+		printDebug(fmt.Sprintf("Exclude Path: %v", ExcludePath))
 		printDebug(fmt.Sprintf("Sort By: %s", SortBy))
+		printDebug(fmt.Sprintf("Reverse: %t", Reverse))
 		printDebug(fmt.Sprintf("White List: %v", WhiteListExtensions))
+		printDebug(fmt.Sprintf("Force Language: %v", ForceLanguage))
+		printDebug(fmt.Sprintf("Count As: %v", countAs))
+		printDebug(fmt.Sprintf("No Complexity Languages: %v", NoComplexityLanguages))
+		printDebug(fmt.Sprintf("Complexity Tokens: %v", ComplexityTokens))
+		printDebug(fmt.Sprintf("Git Ref: %s", GitRef))
+		printDebug(fmt.Sprintf("Git Tracked: %t", gitTracked))
+		printDebug(fmt.Sprintf("Since: %s", Since))
 		printDebug(fmt.Sprintf("Files Output: %t", Files))
+		printDebug(fmt.Sprintf("Path Style: %s", PathStyle))
+		printDebug(fmt.Sprintf("Color: %t", colorEnabled()))
+		printDebug(fmt.Sprintf("Strict: %t", Strict))
+		printDebug(fmt.Sprintf("By Directory: %d", ByDirectory))
+		printDebug(fmt.Sprintf("Percent: %t Percent Of: %s", Percent, PercentOf))
+		printDebug(fmt.Sprintf("Complexity Max: %d Total Complexity Max: %d", ComplexityMax, TotalComplexityMax))
+		printDebug(fmt.Sprintf("Min Comment Ratio: %.2f", MinCommentRatio))
+		printDebug(fmt.Sprintf("Follow Symlinks: %t", FollowSymlinks))
+		printDebug(fmt.Sprintf("Lines Only: %t", LinesOnly))
+		printDebug(fmt.Sprintf("Line Length: %t", LineLength))
+		printDebug(fmt.Sprintf("Max File Size: %d Mmap Threshold: %d", MaxFileSize, MmapThreshold))
+		printDebug(fmt.Sprintf("Minified: %t No Minified: %t Line Length: %d", Minified, NoMinified, MinifiedLineLength))
+		printDebug(fmt.Sprintf("No Generated: %t Markers: %v", NoGen, GeneratedMarkers))
 		printDebug(fmt.Sprintf("Verbose: %t", Verbose))
 		printDebug(fmt.Sprintf("Duplicates Detection: %t", Duplicates))
+		printDebug(fmt.Sprintf("Duplicate Groups: %t", DuplicateGroups))
+		printDebug(fmt.Sprintf("Duplicate Hash: %s", DuplicateHash))
 		printDebug(fmt.Sprintf("Complexity Calculation: %t", !Complexity))
 		printDebug(fmt.Sprintf("Wide: %t", More))
-		printDebug(fmt.Sprintf("Average Wage: %d", AverageWage))
-		printDebug(fmt.Sprintf("Cocomo: %t", !Cocomo))
+		printDebug(fmt.Sprintf("Average Wage: %.2f", AverageWage))
+		printDebug(fmt.Sprintf("Directory Walk Workers: %d", DirectoryWalkJobWorkers))
+		printDebug(fmt.Sprintf("File Read Workers: %d", FileReadJobWorkers))
+		printDebug(fmt.Sprintf("File Process Workers: %d", FileProcessJobWorkers))
+		printDebug(fmt.Sprintf("Cocomo: %t", !NoCocomo))
+		printDebug(fmt.Sprintf("Cocomo Project: %+v", CocomoProject))
+		printDebug(fmt.Sprintf("Cocomo Weights: %v", cocomoWeights))
 	}
+
+	return nil
 }
 
-func loadDatabase() map[string]Language {
+// Loads the languages built into the application along with any from LanguagesFile
+func loadDatabase() (map[string]Language, error) {
 	var database map[string]Language
 	startTime := makeTimestampMilli()
 
 	data, err := base64.StdEncoding.DecodeString(languages)
 	if err != nil {
-		panic(fmt.Sprintf("failed to base64 decode languages: %v", err))
+		return nil, fmt.Errorf("failed to base64 decode languages: %v", err)
 	}
 
 	if err := json.Unmarshal(data, &database); err != nil {
-		panic(fmt.Sprintf("languages json invalid: %v", err))
+		return nil, fmt.Errorf("languages json invalid: %v", err)
+	}
+
+	if LanguagesFile != "" {
+		custom, err := loadLanguagesFile(LanguagesFile)
+		if err != nil {
+			return nil, err
+		}
+		mergeLanguages(database, custom)
 	}
 
 	if Trace {
 		printTrace(fmt.Sprintf("milliseconds unmarshal: %d", makeTimestampMilli()-startTime))
 	}
 
-	return database
+	return database, nil
+}
+
+// Written in the format of languages.json so it can be read back using --languages-file
+func writeLanguagesJson(output io.Writer, database map[string]Language) error {
+	encoder := json.NewEncoder(output)
+	encoder.SetEscapeHTML(false)
+	return encoder.Encode(database)
+}
+
+// Reads language definitions in the same format as languages.json from a file
+// checking every token is set so that a mistake is reported rather than ignored
+func loadLanguagesFile(name string) (map[string]Language, error) {
+	data, err := ioutil.ReadFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read languages file: %s", err)
+	}
+
+	var custom map[string]Language
+	if err := json.Unmarshal(data, &custom); err != nil {
+		return nil, fmt.Errorf("languages file %s is invalid: %s", name, err)
+	}
+
+	for languageName, language := range custom {
+		tokens := []string{}
+		for _, v := range [][]string{language.LineComment, language.ComplexityChecks, language.Heredocs, language.VerbatimPrefixes, language.RawPrefixes, language.Docstrings, language.Imports} {
+			tokens = append(tokens, v...)
+		}
+		for _, pairs := range [][][]string{language.MultiLine, language.Quotes, language.ImportBlocks} {
+			for _, pair := range pairs {
+				if len(pair) != 2 {
+					return nil, fmt.Errorf("languages file %s is invalid: %s must have an open and close for %v", name, languageName, pair)
+				}
+				tokens = append(tokens, pair...)
+			}
+		}
+
+		for _, token := range tokens {
+			if token == "" {
+				return nil, fmt.Errorf("languages file %s is invalid: %s has an empty token", name, languageName)
+			}
+		}
+
+		for _, pattern := range language.TestFiles {
+			if _, err := filepath.Match(pattern, ""); pattern == "" || err != nil {
+				return nil, fmt.Errorf("languages file %s is invalid: %s has an invalid test file pattern %q", name, languageName, pattern)
+			}
+		}
+
+		if len(language.VerbatimEscape) > 1 {
+			return nil, fmt.Errorf("languages file %s is invalid: %s must have a single character verbatim escape not %q", name, languageName, language.VerbatimEscape)
+		}
+	}
+
+	return custom, nil
 }
 
-func printLanguages() {
-	database := loadDatabase()
+// Adds the custom languages to the database replacing any with the same name. The
+// extensions and file names of the custom languages are removed from the others so
+// files are always counted as the custom language
+func mergeLanguages(database map[string]Language, custom map[string]Language) {
+	claimed := map[string]bool{}
+	for name, language := range custom {
+		database[name] = language
+		for _, extension := range language.Extensions {
+			claimed[extension] = true
+		}
+		for _, fileName := range language.FileNames {
+			claimed[strings.ToLower(fileName)] = true
+		}
+	}
+
+	for name, language := range database {
+		if _, ok := custom[name]; ok {
+			continue
+		}
+
+		extensions := []string{}
+		for _, extension := range language.Extensions {
+			if !claimed[extension] {
+				extensions = append(extensions, extension)
+			}
+		}
+
+		fileNames := []string{}
+		for _, fileName := range language.FileNames {
+			if !claimed[strings.ToLower(fileName)] {
+				fileNames = append(fileNames, fileName)
+			}
+		}
+
+		language.Extensions = extensions
+		language.FileNames = fileNames
+		database[name] = language
+	}
+}
+
+func printLanguages() error {
+	database, err := loadDatabase()
+	if err != nil {
+		return err
+	}
+
+	if strings.ToLower(Format) == "json" {
+		return writeLanguagesJson(os.Stdout, database)
+	}
+
 	var names []string
 
 	for key := range database {
@@ -188,18 +787,108 @@ func printLanguages() {
 	})
 
 	for _, name := range names {
-		fmt.Println(fmt.Sprintf("%s (%s)", name, strings.Join(database[name].Extensions, ",")))
+		// Copied so that appending cannot write into the backing array of the database
+		matches := append(append([]string{}, database[name].Extensions...), database[name].FileNames...)
+		fmt.Println(fmt.Sprintf("%s (%s)", name, strings.Join(matches, ",")))
 	}
+
+	return nil
 }
 
-func Process() {
-	if Languages {
-		printLanguages()
-		return
+// Wraps a file so that everything written to it is gzip compressed
+type gzipFile struct {
+	*gzip.Writer
+	file *os.File
+}
+
+func (g *gzipFile) Close() error {
+	if err := g.Writer.Close(); err != nil {
+		g.file.Close()
+		return err
+	}
+
+	return g.file.Close()
+}
+
+// Creates the file results are written to which is gzip compressed
+// when the name ends with .gz
+func createOutputFile(name string) (io.WriteCloser, error) {
+	file, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.HasSuffix(strings.ToLower(name), ".gz") {
+		return &gzipFile{Writer: gzip.NewWriter(file), file: file}, nil
+	}
+
+	return file, nil
+}
+
+func writeOutputFile(name string, write func(io.Writer)) error {
+	file, err := createOutputFile(name)
+	if err != nil {
+		return fmt.Errorf("unable to create output file: %s", err)
+	}
+
+	write(file)
+
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("unable to write output file: %s", err)
+	}
+
+	fmt.Println("results written to " + name)
+	return nil
+}
+
+// Writes each file as it is received for the formats which do not need every file first
+func streamSummarize(input chan *FileJob) error {
+	format := toNdjson
+	if strings.ToLower(Format) == "json" {
+		format = toJsonFiles
+	}
+
+	if FileOutput == "" {
+		format(input, os.Stdout)
+		return nil
+	}
+
+	return writeOutputFile(FileOutput, func(output io.Writer) {
+		format(input, output)
+	})
+}
+
+// Sets up the pipeline which walks, reads and processes the files in DirFilePaths
+// returning the channel which each processed file is written to
+func processFiles(ctx context.Context) (chan *FileJob, error) {
+	fileListQueue, err := walkFiles(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	ProcessConstants()
-	processFlags()
+	fileReadContentJobQueue := make(chan *FileJob, FileReadContentJobQueueSize) // Files ready to be processed
+	fileSummaryJobQueue := make(chan *FileJob, FileSummaryJobQueueSize)         // Files ready to be summerised
+
+	go fileReaderWorker(ctx, fileListQueue, fileReadContentJobQueue)
+	go fileProcessorWorker(ctx, fileReadContentJobQueue, fileSummaryJobQueue)
+
+	if OnFileProcessed != nil {
+		return withFileProcessed(fileSummaryJobQueue, OnFileProcessed), nil
+	}
+
+	return fileSummaryJobQueue, nil
+}
+
+// Starts walking the files in DirFilePaths returning the channel which each file
+// that passes the filters on its name and path is written to before it is read
+func walkFiles(ctx context.Context) (chan *FileJob, error) {
+	if err := processConstants(); err != nil {
+		return nil, err
+	}
+
+	if err := processFlags(); err != nil {
+		return nil, err
+	}
 
 	// Clean up any invalid arguments before setting everything up
 	if len(DirFilePaths) == 0 {
@@ -207,6 +896,7 @@ func Process() {
 	}
 
 	SortBy = strings.ToLower(SortBy)
+	locationRoots = absoluteRoots(DirFilePaths)
 
 	if Debug {
 		printDebug(fmt.Sprintf("NumCPU: %d", runtime.NumCPU()))
@@ -214,20 +904,310 @@ func Process() {
 		printDebug(fmt.Sprintf("PathBlacklist: %v", PathBlacklist))
 	}
 
-	fileListQueue := make(chan *FileJob, FileListQueueSize)                     // Files ready to be read from disk
-	fileReadContentJobQueue := make(chan *FileJob, FileReadContentJobQueueSize) // Files ready to be processed
-	fileSummaryJobQueue := make(chan *FileJob, FileSummaryJobQueueSize)         // Files ready to be summerised
+	skipped.Reset()
+	readErrors.Reset()
+	uniqueLines.Reset()
+	duplicates.Reset()
+
+	fileListQueue := make(chan *FileJob, FileListQueueSize) // Files ready to be read from disk
+
+	// A git ref means the files are read from git rather than the working tree while
+	// a path of - means the list of files to process is supplied on stdin
+	if GitRef != "" {
+		go walkGitRef(ctx, GitRef, DirFilePaths, fileListQueue)
+	} else if gitTracked {
+		go walkGitTracked(ctx, DirFilePaths, fileListQueue)
+	} else if DirFilePaths[0] == "-" {
+		go walkFileList(ctx, os.Stdin, fileListQueue)
+	} else {
+		go walkPaths(ctx, DirFilePaths, fileListQueue)
+	}
+
+	return fileListQueue, nil
+}
+
+// Returns true if any of the filters which can only be applied once a file has been read
+// and counted are set, such as --no-gen, --min-lines or --no-duplicates
+func contentFiltered() bool {
+	return NoGen || NoMinified || Minified || Duplicates || MinLines > 0 || MaxLines > 0 || Since != ""
+}
+
+// Writes the location and language of each file which would be counted without reading
+// them for --dry-run. Only the start of files identified by their #! line is read unless
+// a filter which needs the content is set, when the files are read and counted so that
+// only those which are left once the filters have been applied are written
+func dryRun(ctx context.Context, output io.Writer) error {
+	if contentFiltered() {
+		fileSummaryJobQueue, err := processFiles(ctx)
+		if err != nil {
+			return err
+		}
 
-	go walkDirectoryParallel(DirFilePaths[0], fileListQueue)
-	go fileReaderWorker(fileListQueue, fileReadContentJobQueue)
-	go fileProcessorWorker(fileReadContentJobQueue, fileSummaryJobQueue)
+		for res := range fileSummaryJobQueue {
+			fmt.Fprintf(output, "%s\t%s\n", res.Location, res.Language)
+		}
+		return nil
+	}
+
+	fileListQueue, err := walkFiles(ctx)
+	if err != nil {
+		return err
+	}
+
+	for res := range fileListQueue {
+		if ctx.Err() != nil {
+			continue
+		}
+
+		if res.Language == SheBang {
+			language, ok := orUnknown(detectSheBangFile(res.Location))
+			if !ok {
+				if Verbose {
+					printWarn(fmt.Sprintf("skipping file unknown extension: %s", res.Filename))
+				}
+				continue
+			}
 
-	result := fileSummarize(fileSummaryJobQueue)
+			res.Language = language
+		}
+
+		// Files from archives already have their content so are not on disk to check
+		if res.Content == nil && MaxFileSize > 0 {
+			if info, err := os.Stat(res.Location); err == nil && isOverMaxFileSize(info.Size()) {
+				if Verbose {
+					printWarn(fmt.Sprintf("skipping file over max file size: %s", res.Location))
+				}
+				continue
+			}
+		}
+
+		fmt.Fprintf(output, "%s\t%s\n", formatLocation(res.Location), res.Language)
+	}
+
+	return nil
+}
+
+// ProcessResults processes the files in DirFilePaths using the same settings as Process
+// but returns the summary of each language rather than writing it out which allows
+// scc to be used as a library. The files of each language are included in the summary
+func ProcessResults() ([]LanguageSummary, error) {
+	processMutex.Lock()
+	defer processMutex.Unlock()
+
+	return processResults()
+}
+
+func processResults() ([]LanguageSummary, error) {
+	fileSummaryJobQueue, err := processFiles(context.Background())
+	if err != nil {
+		return nil, err
+	}
 
+	return aggregateLanguageSummary(fileSummaryJobQueue), nil
+}
+
+// Process processes the files in DirFilePaths and writes the summary out using the configured format
+// printing the error and exiting if the settings are invalid or the run fails, such as with --strict.
+// Any profiles set by CpuProfile and MemProfile cover the run and are written before exiting
+func Process() {
+	stopProfiles, err := StartProfiles()
+	if err != nil {
+		printError(err.Error())
+		os.Exit(1)
+	}
+
+	err = ProcessContext(context.Background())
+	profileErr := stopProfiles()
+
+	if err != nil {
+		printErrors(err)
+	}
+
+	if profileErr != nil {
+		printError(profileErr.Error())
+	}
+
+	if err != nil || profileErr != nil {
+		os.Exit(1)
+	}
+}
+
+// ProcessContext is the same as Process but stops walking, reading and processing files
+// once the context is cancelled. When that happens nothing is written and the context
+// error is returned, although streamed formats may have already written partial output.
+// An error is also returned rather than exiting if the settings are invalid or the run
+// fails, with the failures of checks such as --strict returned once the output is written
+func ProcessContext(ctx context.Context) error {
+	processMutex.Lock()
+	defer processMutex.Unlock()
+
+	return processContext(ctx)
+}
+
+func processContext(ctx context.Context) error {
+	if Languages {
+		return printLanguages()
+	}
+
+	if DebugFile != "" {
+		if err := processConstants(); err != nil {
+			return err
+		}
+
+		if err := processFlags(); err != nil {
+			return err
+		}
+
+		return debugFile(DebugFile, os.Stderr)
+	}
+
+	if Diff {
+		if len(DirFilePaths) != 2 {
+			return errors.New("--diff requires the old and new JSON reports to compare")
+		}
+
+		result, err := diffSummarize(DirFilePaths[0], DirFilePaths[1])
+		if err != nil {
+			return err
+		}
+
+		return writeResult(result)
+	}
+
+	if DryRun {
+		if err := dryRun(ctx, os.Stdout); err != nil {
+			return err
+		}
+		return ctx.Err()
+	}
+
+	fileSummaryJobQueue, err := processFiles(ctx)
+	if err != nil {
+		return err
+	}
+
+	budget := &complexityBudget{}
+	if ComplexityMax > 0 || TotalComplexityMax > 0 {
+		fileSummaryJobQueue = withComplexityBudget(fileSummaryJobQueue, budget)
+	}
+
+	if showProgress() {
+		fileSummaryJobQueue = withProgress(fileSummaryJobQueue, os.Stdout)
+	}
+
+	// Streamed formats write each result as it arrives rather than building the output in memory
+	if strings.ToLower(Format) == "ndjson" || FilesOnly {
+		if err := streamSummarize(fileSummaryJobQueue); err != nil {
+			return err
+		}
+
+		if err := collectErrors(strictError(), budget.err()); err != nil {
+			return err
+		}
+		return ctx.Err()
+	}
+
+	result, language, err := fileSummarize(fileSummaryJobQueue)
+
+	if ctx.Err() != nil {
+		return ctx.Err()
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if err := writeResult(result); err != nil {
+		return err
+	}
+
+	return collectErrors(strictError(), budget.err(), commentBudgetError(language))
+}
+
+// Passes the jobs through calling the callback with each one from a single goroutine
+func withFileProcessed(input chan *FileJob, callback func(*FileJob)) chan *FileJob {
+	forwarded := make(chan *FileJob, FileSummaryJobQueueSize)
+
+	go func() {
+		for res := range input {
+			callback(res)
+			forwarded <- res
+		}
+		close(forwarded)
+	}()
+
+	return forwarded
+}
+
+// Returns an error listing every file which could not be read when Strict is set
+// so that a count which is missing files is not mistaken for a complete one
+func strictError() error {
+	if !Strict {
+		return nil
+	}
+
+	files := readErrors.Files()
+	if len(files) == 0 {
+		return nil
+	}
+
+	var str strings.Builder
+	str.WriteString(fmt.Sprintf("%d files could not be read", len(files)))
+	for _, file := range files {
+		str.WriteString(fmt.Sprintf("\n%s: %s", file.Location, file.Reason))
+	}
+
+	return errors.New(str.String())
+}
+
+// The failures of the checks run once the output is written, such as --strict and
+// --complexity-max, which are kept apart so each can be printed as its own error
+type runErrors []error
+
+func (e runErrors) Error() string {
+	messages := []string{}
+	for _, err := range e {
+		messages = append(messages, err.Error())
+	}
+
+	return strings.Join(messages, "\n")
+}
+
+// Returns the errors which are not nil as a single error or nil if there are none
+func collectErrors(errs ...error) error {
+	failed := runErrors{}
+	for _, err := range errs {
+		if err != nil {
+			failed = append(failed, err)
+		}
+	}
+
+	if len(failed) == 0 {
+		return nil
+	}
+	return failed
+}
+
+// Prints the error or each of the errors when it is made up of more than one
+func printErrors(err error) {
+	if errs, ok := err.(runErrors); ok {
+		for _, err := range errs {
+			printError(err.Error())
+		}
+		return
+	}
+
+	printError(err.Error())
+}
+
+// Prints the result or writes it to the output file if one was set
+func writeResult(result string) error {
 	if FileOutput == "" {
 		fmt.Println(result)
-	} else {
-		ioutil.WriteFile(FileOutput, []byte(result), 0600)
-		fmt.Println("results written to " + FileOutput)
+		return nil
 	}
+
+	return writeOutputFile(FileOutput, func(output io.Writer) {
+		io.WriteString(output, result)
+	})
 }
@@ -1,7 +1,21 @@
 package processor
 
 import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime/debug"
+	"sort"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestProcessConstants(t *testing.T) {
@@ -15,3 +29,626 @@ func TestProcessConstants(t *testing.T) {
 		t.Error("Should not be 0")
 	}
 }
+
+func TestProcessConstantsNoComplexityLanguages(t *testing.T) {
+	NoComplexityLanguages = []string{"python"}
+	ProcessConstants()
+	defer func() {
+		NoComplexityLanguages = []string{}
+		ProcessConstants()
+	}()
+
+	count := func(language string, content string) int64 {
+		fileJob := &FileJob{Language: language, Content: []byte(content)}
+		CountStats(fileJob)
+		return fileJob.Complexity
+	}
+
+	if got := count("Python", "if x:\n    y = 1\n"); got != 0 {
+		t.Errorf("Expected no complexity for Python got %d", got)
+	}
+
+	if got := count("Go", "if x {\n}\n"); got != 1 {
+		t.Errorf("Expected complexity of 1 for Go got %d", got)
+	}
+
+	if !isLanguage(" go ") || isLanguage("Unknown") {
+		t.Error("Expected languages to be matched ignoring case and space")
+	}
+}
+
+func TestProcessConstantsComplexityTokens(t *testing.T) {
+	ComplexityTokens = []string{"c:RETRY(", "Go:must(", "invalid"}
+	ProcessConstants()
+	defer func() {
+		ComplexityTokens = []string{}
+		ProcessConstants()
+	}()
+
+	count := func(language string, content string) int64 {
+		fileJob := &FileJob{Language: language, Content: []byte(content)}
+		CountStats(fileJob)
+		return fileJob.Complexity
+	}
+
+	if got := count("C", "RETRY(connect());\nif (x) {}\n"); got != 2 {
+		t.Errorf("Expected complexity of 2 for C got %d", got)
+	}
+
+	if got := count("C++", "RETRY(connect());\n"); got != 0 {
+		t.Errorf("Expected no complexity for C++ got %d", got)
+	}
+
+	if got := count("Go", "must(x)\n"); got != 1 {
+		t.Errorf("Expected complexity of 1 for Go got %d", got)
+	}
+}
+
+func TestGetLanguageFeature(t *testing.T) {
+	feature, ok := GetLanguageFeature("Go")
+	if !ok {
+		t.Fatal("Expected Go to be found")
+	}
+
+	if tokenType, _, _ := feature.SingleLineComments.Match([]byte("// comment")); tokenType != T_SLCOMMENT {
+		t.Errorf("Expected // to be a comment got %d", tokenType)
+	}
+
+	if _, ok := GetLanguageFeature("Unknown"); ok {
+		t.Error("Expected Unknown to not be found")
+	}
+}
+
+func TestLanguageExtensions(t *testing.T) {
+	extensions := LanguageExtensions("C++")
+	if len(extensions) == 0 || extensions[0] != "c++" {
+		t.Errorf("Expected sorted extensions got %v", extensions)
+	}
+
+	if extensions := LanguageExtensions("Unknown"); len(extensions) != 0 {
+		t.Errorf("Expected no extensions got %v", extensions)
+	}
+
+	// Shared by Boo, LaTeX and TeX while files with it can only be counted as one of them
+	for _, name := range []string{"Boo", "LaTeX", "TeX"} {
+		found := false
+		for _, extension := range LanguageExtensions(name) {
+			found = found || extension == "tex"
+		}
+
+		if !found {
+			t.Errorf("Expected tex in the extensions of %s", name)
+		}
+	}
+}
+
+func TestCountBytes(t *testing.T) {
+	content := []byte("package main\n\n// main does nothing\nfunc main() {\n\tif true {\n\t}\n}\n")
+	res := CountBytes("Go", content)
+
+	if res.Language != "Go" || res.Bytes != int64(len(content)) {
+		t.Errorf("Expected Go with %d bytes got %s with %d", len(content), res.Language, res.Bytes)
+	}
+
+	if res.Lines != 7 || res.Code != 5 || res.Comment != 1 || res.Blank != 1 || res.Complexity != 1 {
+		t.Errorf("Expected 7 lines 5 code 1 comment 1 blank 1 complexity got %d %d %d %d %d", res.Lines, res.Code, res.Comment, res.Blank, res.Complexity)
+	}
+
+	res = CountBytes("Unknown", []byte("# not a comment\n\n"))
+	if res.Lines != 2 || res.Code != 1 || res.Blank != 1 {
+		t.Errorf("Expected 1 code and 1 blank line got %d %d", res.Code, res.Blank)
+	}
+
+	res = CountBytes(JupyterLanguage, []byte(`{"cells": [{"cell_type": "code", "source": ["x = 1  # one\n", "y = 2"]}]}`))
+	if res.Language != JupyterLanguage || res.Code != 2 {
+		t.Errorf("Expected 2 lines of notebook code got %s %d", res.Language, res.Code)
+	}
+}
+
+func TestLoadDatabaseLanguagesFile(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "languages.json")
+	ioutil.WriteFile(name, []byte(`{
+	"Go": {"extensions": ["go"], "line_comment": [";"]},
+	"Rules": {"extensions": ["rules", "c"], "line_comment": ["--"], "quotes": [["'", "'"]]}
+}`), 0600)
+
+	LanguagesFile = name
+	defer func() { LanguagesFile = "" }()
+
+	database, err := loadDatabase()
+	if err != nil {
+		t.Fatalf("Expected no error got %s", err)
+	}
+
+	if comments := database["Go"].LineComment; len(comments) != 1 || comments[0] != ";" {
+		t.Errorf("Expected Go to be overridden got %v", comments)
+	}
+
+	if _, ok := database["Rules"]; !ok {
+		t.Error("Expected Rules to be added")
+	}
+
+	for _, extension := range database["C"].Extensions {
+		if extension == "c" {
+			t.Error("Expected c to be counted as Rules")
+		}
+	}
+}
+
+// Run using go test -race -run TestProcessConstantsConcurrent to check the maps are not raced on
+func TestProcessConstantsConcurrent(t *testing.T) {
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			if err := ProcessConstants(); err != nil {
+				t.Errorf("Expected no error got %s", err)
+			}
+
+			if _, ok := GetLanguageFeature("Go"); !ok {
+				t.Error("Expected Go to be found")
+			}
+
+			if extensions := LanguageExtensions("Go"); len(extensions) != 1 || extensions[0] != "go" {
+				t.Errorf("Expected go got %v", extensions)
+			}
+
+			if res := CountBytes("Go", []byte("package main\n// main\n")); res.Code != 1 || res.Comment != 1 {
+				t.Errorf("Expected 1 code and 1 comment line got %d %d", res.Code, res.Comment)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if ExtensionToLanguage["go"] != "Go" || len(LanguageFeatures) == 0 {
+		t.Error("Expected the languages to be loaded once every call has finished")
+	}
+}
+
+func TestProcessConstantsInvalidLanguagesFile(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "languages.json")
+	ioutil.WriteFile(name, []byte(`{"Go": `), 0600)
+
+	LanguagesFile = name
+	defer func() { LanguagesFile = "" }()
+
+	if _, err := loadDatabase(); err == nil {
+		t.Error("Expected error for invalid languages file")
+	}
+
+	if err := ProcessConstants(); err == nil {
+		t.Error("Expected error for invalid languages file")
+	}
+
+	DirFilePaths = []string{dir}
+	defer func() { DirFilePaths = []string{} }()
+
+	if results, err := ProcessResults(); err == nil || results != nil {
+		t.Errorf("Expected error and no results got %v %v", results, err)
+	}
+
+	if err := ProcessContext(context.Background()); err == nil {
+		t.Error("Expected error from ProcessContext")
+	}
+}
+
+func TestWriteLanguagesJson(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	name := filepath.Join(dir, "languages.json")
+	output, _ := os.Create(name)
+	builtIn, _ := loadDatabase()
+	err := writeLanguagesJson(output, builtIn)
+	output.Close()
+	if err != nil {
+		t.Fatalf("Expected no error got %s", err)
+	}
+
+	// The output should be usable as a languages file
+	database, err := loadLanguagesFile(name)
+	if err != nil {
+		t.Fatalf("Expected no error got %s", err)
+	}
+
+	if len(database) != len(builtIn) {
+		t.Errorf("Expected %d languages got %d", len(builtIn), len(database))
+	}
+
+	goLanguage := database["Go"]
+	if len(goLanguage.LineComment) != 1 || goLanguage.LineComment[0] != "//" || goLanguage.Quotes[1][0] != "`" {
+		t.Errorf("Unexpected Go definition %+v", goLanguage)
+	}
+}
+
+func TestLoadLanguagesFileInvalid(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	for content, expected := range map[string]string{
+		`{"Rules": `:                           "is invalid",
+		`{"Rules": {"multi_line": [["/*"]]}}`:  "must have an open and close",
+		`{"Rules": {"line_comment": [""]}}`:    "has an empty token",
+		`{"Rules": {"quotes": [["'", ""]]}}`:   "has an empty token",
+		`{"Rules": {"verbatim_escape": "ab"}}`: "single character verbatim escape",
+		`{"Rules": {"extensions": ["rules"]}}`: "",
+	} {
+		name := filepath.Join(dir, "languages.json")
+		ioutil.WriteFile(name, []byte(content), 0600)
+
+		_, err := loadLanguagesFile(name)
+		if expected == "" && err != nil {
+			t.Errorf("Expected no error for %s got %s", content, err)
+		}
+		if expected != "" && (err == nil || !strings.Contains(err.Error(), expected)) {
+			t.Errorf("Expected error containing %s for %s got %v", expected, content, err)
+		}
+	}
+
+	if _, err := loadLanguagesFile(filepath.Join(dir, "missing.json")); err == nil {
+		t.Error("Expected error for missing file")
+	}
+}
+
+func TestCreateOutputFileGzip(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	location := filepath.Join(dir, "output.json.gz")
+	file, err := createOutputFile(location)
+	if err != nil {
+		t.Fatal(err)
+	}
+	io.WriteString(file, "compressed")
+	file.Close()
+
+	compressed, _ := os.Open(location)
+	defer compressed.Close()
+
+	reader, err := gzip.NewReader(compressed)
+	if err != nil {
+		t.Fatalf("Expected gzip output got %s", err)
+	}
+
+	content, _ := ioutil.ReadAll(reader)
+	if string(content) != "compressed" {
+		t.Errorf("Expected compressed got %s", content)
+	}
+}
+
+func TestCreateOutputFilePlain(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	location := filepath.Join(dir, "output.json")
+	file, _ := createOutputFile(location)
+	io.WriteString(file, "plain")
+	file.Close()
+
+	if content, _ := ioutil.ReadFile(location); string(content) != "plain" {
+		t.Errorf("Expected plain got %s", content)
+	}
+}
+
+func TestProcessFlagsMaxWorkers(t *testing.T) {
+	walkWorkers, readWorkers, processWorkers := DirectoryWalkJobWorkers, FileReadJobWorkers, FileProcessJobWorkers
+	defer func() {
+		DirectoryWalkJobWorkers, FileReadJobWorkers, FileProcessJobWorkers, MaxWorkers = walkWorkers, readWorkers, processWorkers, 0
+	}()
+
+	DirectoryWalkJobWorkers, FileReadJobWorkers, FileProcessJobWorkers, MaxWorkers = 64, 384, 4, 8
+	processFlags()
+
+	if DirectoryWalkJobWorkers != 8 || FileReadJobWorkers != 8 || FileProcessJobWorkers != 4 {
+		t.Errorf("Expected 8, 8 and 4 workers got %d, %d and %d", DirectoryWalkJobWorkers, FileReadJobWorkers, FileProcessJobWorkers)
+	}
+
+	FileReadJobWorkers, MaxWorkers = 384, 0
+	processFlags()
+
+	if FileReadJobWorkers != 384 {
+		t.Errorf("Expected workers to be unchanged got %d", FileReadJobWorkers)
+	}
+}
+
+func TestProcessFlagsCountAs(t *testing.T) {
+	ProcessConstants()
+	CountAs = []string{"go:java"}
+	defer func() {
+		CountAs = []string{}
+		processFlags()
+	}()
+
+	if err := processFlags(); err != nil {
+		t.Fatalf("Expected no error got %s", err)
+	}
+
+	if ExtensionToLanguage["go"] != "Go" {
+		t.Errorf("Expected the extensions of the languages to be unchanged got %s", ExtensionToLanguage["go"])
+	}
+
+	if language := getExtensionLookup()["go"]; language != "Java" {
+		t.Errorf("Expected go to be counted as Java got %s", language)
+	}
+
+	CountAs = []string{}
+	processFlags()
+
+	if language := getExtensionLookup()["go"]; language != "Go" {
+		t.Errorf("Expected go to be counted as Go by the next run got %s", language)
+	}
+}
+
+func TestProcessFlagsGitTracked(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	DirFilePaths = []string{dir}
+	GitTracked = true
+	defer func() {
+		DirFilePaths = []string{}
+		GitTracked = false
+		gitTracked = false
+	}()
+
+	if err := processFlags(); err != nil {
+		t.Fatalf("Expected no error got %s", err)
+	}
+
+	// Outside of a repository the files are walked without changing the setting for the next run
+	if !GitTracked || gitTracked {
+		t.Errorf("Expected GitTracked to be kept and the run to walk the files got %t %t", GitTracked, gitTracked)
+	}
+}
+
+func TestProcessResults(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	ioutil.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n\n// main\nfunc main() {}\n"), 0600)
+	ioutil.WriteFile(filepath.Join(dir, "other.go"), []byte("package main\n"), 0600)
+	ioutil.WriteFile(filepath.Join(dir, "Main.java"), []byte("class Main {}\n"), 0600)
+
+	DirFilePaths = []string{dir}
+	defer func() { DirFilePaths = []string{} }()
+
+	results, err := ProcessResults()
+	if err != nil {
+		t.Fatalf("Expected no error got %s", err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 languages got %d", len(results))
+	}
+
+	if results[0].Name != "Go" || results[0].Count != 2 || results[0].Lines != 5 || results[0].Code != 3 || results[0].Comment != 1 || len(results[0].Files) != 2 {
+		t.Errorf("Unexpected Go summary %+v", results[0])
+	}
+
+	if results[1].Name != "Java" || results[1].Count != 1 || results[1].Bytes != 14 {
+		t.Errorf("Unexpected Java summary %+v", results[1])
+	}
+}
+
+func TestProcessResultsOnFileProcessed(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	ioutil.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), 0600)
+	ioutil.WriteFile(filepath.Join(dir, "Main.java"), []byte("class Main {}\n"), 0600)
+
+	DirFilePaths = []string{dir}
+	defer func() { DirFilePaths = []string{} }()
+
+	// Appended to without a lock as the callback is only ever called from one goroutine
+	processed := []string{}
+	OnFileProcessed = func(job *FileJob) {
+		processed = append(processed, job.Language)
+	}
+	defer func() { OnFileProcessed = nil }()
+
+	results, _ := ProcessResults()
+
+	sort.Strings(processed)
+	if len(results) != 2 || len(processed) != 2 || processed[0] != "Go" || processed[1] != "Java" {
+		t.Errorf("Expected callback for each file got %v", processed)
+	}
+}
+
+func TestDryRun(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	os.Mkdir(filepath.Join(dir, "vendor"), 0700)
+	ioutil.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), 0600)
+	ioutil.WriteFile(filepath.Join(dir, "run"), []byte("#!/usr/bin/env python\nprint(1)\n"), 0600)
+	ioutil.WriteFile(filepath.Join(dir, "unknown.unknown"), []byte("unknown\n"), 0600)
+	ioutil.WriteFile(filepath.Join(dir, "vendor", "lib.go"), []byte("package lib\n"), 0600)
+
+	excludeDir := ExcludeDir
+	DirFilePaths = []string{dir}
+	ExcludeDir = []string{"vendor"}
+	PathStyle = PathRelative
+	defer func() {
+		DirFilePaths = []string{}
+		ExcludeDir = excludeDir
+		PathStyle = ""
+		skipped.Reset()
+	}()
+
+	var output strings.Builder
+	dryRun(context.Background(), &output)
+
+	lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+	sort.Strings(lines)
+
+	expected := []string{"main.go\tGo", "run\tPython"}
+	if !reflect.DeepEqual(lines, expected) {
+		t.Errorf("Expected %q got %q", expected, lines)
+	}
+}
+
+func TestDryRunContentFilters(t *testing.T) {
+	ProcessConstants()
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	ioutil.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n\nfunc main() {}\n"), 0600)
+	ioutil.WriteFile(filepath.Join(dir, "api.pb.go"), []byte("// Code generated by protoc-gen-go. DO NOT EDIT.\npackage api\n\nvar a = 1\n"), 0600)
+	ioutil.WriteFile(filepath.Join(dir, "short.go"), []byte("package short\n"), 0600)
+
+	DirFilePaths = []string{dir}
+	PathStyle = PathRelative
+	NoGen = true
+	MinLines = 2
+	defer func() {
+		DirFilePaths = []string{}
+		PathStyle = ""
+		NoGen = false
+		MinLines = 0
+		skipped.Reset()
+	}()
+
+	var output strings.Builder
+	if err := dryRun(context.Background(), &output); err != nil {
+		t.Fatalf("Expected no error got %s", err)
+	}
+
+	if got := strings.TrimSpace(output.String()); got != "main.go\tGo" {
+		t.Errorf("Expected only main.go got %q", got)
+	}
+}
+
+func TestProcessContextCancelled(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	for i := 0; i < 100; i++ {
+		ioutil.WriteFile(filepath.Join(dir, fmt.Sprintf("file%d.go", i)), []byte("package main\n"), 0600)
+	}
+
+	DirFilePaths = []string{dir}
+	defer func() { DirFilePaths = []string{} }()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	done := make(chan error)
+	go func() {
+		done <- ProcessContext(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		if err != context.Canceled {
+			t.Errorf("Expected %v got %v", context.Canceled, err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Error("Expected cancelled scan to return")
+	}
+}
+
+func TestStrictError(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+	defer readErrors.Reset()
+
+	ioutil.WriteFile(filepath.Join(dir, "main.go"), []byte("package main\n"), 0600)
+	os.Mkdir(filepath.Join(dir, "dir.go"), 0700)
+
+	input := make(chan *FileJob, 10)
+	output := make(chan *FileJob, 10)
+	input <- &FileJob{Location: filepath.Join(dir, "main.go"), Language: "Go"}
+	input <- &FileJob{Location: filepath.Join(dir, "missing.go"), Language: "Go"}
+	input <- &FileJob{Location: filepath.Join(dir, "dir.go"), Language: "Go"}
+	close(input)
+
+	fileReaderWorker(context.Background(), input, output)
+	for range output {
+	}
+
+	if err := strictError(); err != nil {
+		t.Errorf("Expected no error without Strict got %s", err)
+	}
+
+	Strict = true
+	defer func() { Strict = false }()
+
+	err := strictError()
+	if err == nil {
+		t.Fatal("Expected error with Strict")
+	}
+
+	for _, line := range []string{"2 files could not be read", "missing.go: ", "dir.go: "} {
+		if !strings.Contains(err.Error(), line) {
+			t.Errorf("Expected %s in %s", line, err)
+		}
+	}
+
+	readErrors.Reset()
+	if err := strictError(); err != nil {
+		t.Errorf("Expected no error when every file was read got %s", err)
+	}
+}
+
+func TestConfigureGcAuto(t *testing.T) {
+	GcAuto = true
+	defer func() { GcAuto = false }()
+
+	current := debug.SetGCPercent(100)
+	defer debug.SetGCPercent(current)
+
+	ConfigureGc()
+
+	if percent := debug.SetGCPercent(100); percent != 100 {
+		t.Errorf("Expected %v got %v", 100, percent)
+	}
+
+	if gcPercent != -1 {
+		t.Errorf("Expected %v got %v", -1, gcPercent)
+	}
+}
+
+func TestProcessPathStyleSkippedAndDuplicates(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	os.Mkdir(filepath.Join(dir, "src"), 0700)
+	ioutil.WriteFile(filepath.Join(dir, "src", "a.go"), []byte("package main\n"), 0600)
+	ioutil.WriteFile(filepath.Join(dir, "src", "b.go"), []byte("package main\n"), 0600)
+
+	cfg := DefaultConfig()
+	cfg.DirFilePaths = []string{dir}
+	cfg.FileOutput = filepath.Join(dir, "out.json")
+	cfg.Format = "json"
+	cfg.PathStyle = PathRelative
+	cfg.Duplicates = true
+	cfg.DuplicateGroups = true
+	cfg.ShowSkipped = true
+
+	if err := ProcessContextConfig(context.Background(), cfg); err != nil {
+		t.Fatalf("Expected no error got %s", err)
+	}
+
+	content, _ := ioutil.ReadFile(cfg.FileOutput)
+	var res jsonSummary
+	json.Unmarshal(content, &res)
+
+	// Which of the two is the duplicate depends on which is processed first
+	if len(res.Skipped) != 1 || (res.Skipped[0].Location != "src/a.go" && res.Skipped[0].Location != "src/b.go") {
+		t.Errorf("Expected a relative skipped location got %+v", res.Skipped)
+	}
+
+	expected := [][]string{{"src/a.go", "src/b.go"}}
+	if !reflect.DeepEqual(res.Duplicates, expected) {
+		t.Errorf("Expected %v got %v", expected, res.Duplicates)
+	}
+}
@@ -0,0 +1,56 @@
+package processor
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+	"runtime/pprof"
+)
+
+// StartProfiles starts writing a CPU profile to CpuProfile when it is set and returns the
+// function to call once processing has finished, which stops the CPU profile and writes
+// the heap profile to MemProfile when that is set. Both can be read with go tool pprof.
+// Process calls it itself so it is only needed when using ProcessContext or ProcessResults.
+// An error is returned if the CPU profile cannot be started or the heap profile written
+func StartProfiles() (func() error, error) {
+	var cpuFile *os.File
+	if CpuProfile != "" {
+		var err error
+		cpuFile, err = os.Create(CpuProfile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create cpu profile: %s", err)
+		}
+
+		if err := pprof.StartCPUProfile(cpuFile); err != nil {
+			cpuFile.Close()
+			return nil, fmt.Errorf("unable to start cpu profile: %s", err)
+		}
+	}
+
+	return func() error {
+		if cpuFile != nil {
+			pprof.StopCPUProfile()
+			cpuFile.Close()
+		}
+
+		if MemProfile != "" {
+			if err := writeMemProfile(MemProfile); err != nil {
+				return fmt.Errorf("unable to write memory profile: %s", err)
+			}
+		}
+
+		return nil
+	}, nil
+}
+
+// Writes the heap profile after a collection so that it shows what is still in use
+func writeMemProfile(name string) error {
+	file, err := os.Create(name)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	runtime.GC()
+	return pprof.WriteHeapProfile(file)
+}
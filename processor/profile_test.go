@@ -0,0 +1,61 @@
+package processor
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStartProfiles(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	CpuProfile = filepath.Join(dir, "cpu.pprof")
+	MemProfile = filepath.Join(dir, "mem.pprof")
+	defer func() {
+		CpuProfile = ""
+		MemProfile = ""
+	}()
+
+	stopProfiles, err := StartProfiles()
+	if err != nil {
+		t.Fatalf("Expected no error got %s", err)
+	}
+
+	if err := stopProfiles(); err != nil {
+		t.Errorf("Expected no error got %s", err)
+	}
+
+	for _, name := range []string{CpuProfile, MemProfile} {
+		info, err := os.Stat(name)
+		if err != nil || info.Size() == 0 {
+			t.Errorf("Expected profile %s to be written got %v", name, err)
+		}
+	}
+}
+
+func TestStartProfilesError(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+	defer func() {
+		CpuProfile = ""
+		MemProfile = ""
+	}()
+
+	CpuProfile = filepath.Join(dir, "missing", "cpu.pprof")
+	if _, err := StartProfiles(); err == nil {
+		t.Error("Expected error for a cpu profile which cannot be created")
+	}
+
+	CpuProfile = ""
+	MemProfile = filepath.Join(dir, "missing", "mem.pprof")
+	stopProfiles, err := StartProfiles()
+	if err != nil {
+		t.Fatalf("Expected no error got %s", err)
+	}
+
+	if err := stopProfiles(); err == nil {
+		t.Error("Expected error for a memory profile which cannot be written")
+	}
+}
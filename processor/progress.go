@@ -0,0 +1,66 @@
+package processor
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// How often the count of processed files is redrawn
+var progressInterval = 100 * time.Millisecond
+
+// Progress is only shown for interactive runs where the tabular output is written to
+// the terminal as anything else is either being read by a machine or is already noisy
+func showProgress() bool {
+	if FileOutput != "" || Verbose || Debug || Trace || formatTemplate != nil {
+		return false
+	}
+
+	format := strings.ToLower(Format)
+	if format != "" && format != "tabular" && format != "wide" {
+		return false
+	}
+
+	return isTerminal(os.Stdout)
+}
+
+func isTerminal(file *os.File) bool {
+	info, err := file.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// Passes the jobs through while redrawing the count of files processed in place. The line
+// is cleared once the input is closed so nothing is left behind when the summary is written
+func withProgress(input chan *FileJob, output io.Writer) chan *FileJob {
+	forwarded := make(chan *FileJob, FileSummaryJobQueueSize)
+
+	go func() {
+		ticker := time.NewTicker(progressInterval)
+		defer ticker.Stop()
+
+		var count int64
+		width := 0
+
+		for {
+			select {
+			case res, ok := <-input:
+				if !ok {
+					fmt.Fprintf(output, "\r%s\r", strings.Repeat(" ", width))
+					close(forwarded)
+					return
+				}
+
+				count++
+				forwarded <- res
+			case <-ticker.C:
+				line := fmt.Sprintf("files processed: %d", count)
+				width = len(line)
+				fmt.Fprintf(output, "\r%s", line)
+			}
+		}
+	}()
+
+	return forwarded
+}
@@ -0,0 +1,57 @@
+package processor
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWithProgress(t *testing.T) {
+	progressInterval = time.Millisecond
+	defer func() { progressInterval = 100 * time.Millisecond }()
+
+	input := make(chan *FileJob)
+	var output bytes.Buffer
+	forwarded := withProgress(input, &output)
+
+	go func() {
+		for i := 0; i < 3; i++ {
+			input <- &FileJob{Location: "main.go"}
+			time.Sleep(5 * time.Millisecond)
+		}
+		close(input)
+	}()
+
+	count := 0
+	for range forwarded {
+		count++
+	}
+
+	if count != 3 {
+		t.Errorf("Expected 3 jobs forwarded got %d", count)
+	}
+
+	got := output.String()
+	if !strings.Contains(got, "\rfiles processed: ") {
+		t.Errorf("Expected progress to be written got %q", got)
+	}
+
+	// The last thing written should clear the line for the summary
+	if !strings.HasSuffix(got, "\r") || strings.TrimSpace(got[strings.LastIndex(got[:len(got)-1], "\r"):]) != "" {
+		t.Errorf("Expected the line to be cleared got %q", got)
+	}
+}
+
+func TestShowProgress(t *testing.T) {
+	// Tests are never run with a terminal as the output
+	if showProgress() {
+		t.Error("Expected no progress when stdout is not a terminal")
+	}
+
+	Format = "json"
+	defer func() { Format = "" }()
+	if showProgress() {
+		t.Error("Expected no progress for json")
+	}
+}
@@ -2,7 +2,9 @@ package processor
 
 import (
 	"bytes"
+	"sort"
 	"sync"
+	"time"
 )
 
 const (
@@ -10,16 +12,29 @@ const (
 	T_SLCOMMENT
 	T_MLCOMMENT
 	T_COMPLEXITY
+	T_HEREDOC
+	T_VERBATIM
+	T_RAWSTRING
 )
 
 type Language struct {
-	LineComment      []string   `json:"line_comment"`
-	ComplexityChecks []string   `json:"complexitychecks"`
-	Extensions       []string   `json:"extensions"`
-	ExtensionFile    bool       `json:"extensionFile"`
-	MultiLine        [][]string `json:"multi_line"`
-	Quotes           [][]string `json:"quotes"`
-	NestedMultiLine  bool       `json:"nestedmultiline"`
+	LineComment      []string   `json:"line_comment,omitempty"`
+	ComplexityChecks []string   `json:"complexitychecks,omitempty"`
+	Extensions       []string   `json:"extensions,omitempty"`
+	FileNames        []string   `json:"filenames,omitempty"`
+	ExtensionFile    bool       `json:"extensionFile,omitempty"`
+	MultiLine        [][]string `json:"multi_line,omitempty"`
+	Quotes           [][]string `json:"quotes,omitempty"`
+	NestedMultiLine  bool       `json:"nestedmultiline,omitempty"`
+	SheBangs         []string   `json:"shebangs,omitempty"`
+	Heredocs         []string   `json:"heredocs,omitempty"`
+	VerbatimPrefixes []string   `json:"verbatim_prefixes,omitempty"`
+	VerbatimEscape   string     `json:"verbatim_escape,omitempty"`
+	RawPrefixes      []string   `json:"raw_string_prefixes,omitempty"`
+	Docstrings       []string   `json:"docstrings,omitempty"`
+	Imports          []string   `json:"imports,omitempty"`
+	ImportBlocks     [][]string `json:"import_blocks,omitempty"`
+	TestFiles        []string   `json:"test_files,omitempty"`
 }
 
 type LanguageFeature struct {
@@ -28,7 +43,12 @@ type LanguageFeature struct {
 	SingleLineComments    *Trie
 	Strings               *Trie
 	Tokens                *Trie
+	Docstrings            [][]byte
+	Imports               [][]byte
+	ImportBlocks          []OpenClose
+	TestFiles             []string
 	Nested                bool
+	VerbatimEscape        byte
 	ComplexityCheckMask   byte
 	SingleLineCommentMask byte
 	MultiLineCommentMask  byte
@@ -47,30 +67,78 @@ type FileJob struct {
 	Filename           string
 	Extension          string
 	Location           string
-	Content            []byte
+	Content            []byte `json:"-"`
 	Bytes              int64
 	Lines              int64
 	Code               int64
 	Comment            int64
 	Blank              int64
 	Complexity         int64
+	Tokens             int64
+	Imports            int64 `json:",omitempty"`
 	WeightedComplexity float64
+	MaxLineLength      int64      `json:",omitempty"`
+	AverageLineLength  float64    `json:",omitempty"`
+	ModTime            *time.Time `json:",omitempty"`
 	Hash               []byte
-	Callback           FileJobCallback
+	Callback           FileJobCallback `json:"-"`
 	Binary             bool
+	codeLineHashes     []uint64
+	unmap              func() error
+	lineLengthSum      int64
+	lineLengthLines    int64
+	sectionFile        *int32
+	extraSection       bool
 }
 
+// LanguageSummary is the sum of the files of a language, which is a row of the tabular output
+// and an entry of Languages in the JSON output. The JSON names are the field names and are only
+// changed along with JsonSchemaVersion. Count is the Files column and Comment and Blank are the
+// Comments and Blanks columns while the rest have the same name as their column
 type LanguageSummary struct {
-	Name               string
-	Bytes              int64
-	Lines              int64
-	Code               int64
-	Comment            int64
-	Blank              int64
-	Complexity         int64
-	Count              int64
-	WeightedComplexity float64
-	Files              []*FileJob
+	Name                string     `json:"Name"`
+	Bytes               int64      `json:"Bytes"`
+	Lines               int64      `json:"Lines"`
+	Code                int64      `json:"Code"`
+	Comment             int64      `json:"Comment"`
+	Blank               int64      `json:"Blank"`
+	Complexity          int64      `json:"Complexity"`
+	Tokens              int64      `json:"Tokens"`
+	Count               int64      `json:"Count"`
+	Imports             int64      `json:"Imports,omitempty"`
+	WeightedComplexity  float64    `json:"WeightedComplexity"`
+	AverageLines        float64    `json:"AverageLines"`
+	AverageComplexity   float64    `json:"AverageComplexity"`
+	Percentage          float64    `json:"Percentage"`
+	BlankRatio          *float64   `json:"BlankRatio,omitempty"`
+	CommentRatio        *float64   `json:"CommentRatio,omitempty"`
+	CodeRatio           *float64   `json:"CodeRatio,omitempty"`
+	MaxLineLength       int64      `json:"MaxLineLength,omitempty"`
+	AverageLineLength   float64    `json:"AverageLineLength,omitempty"`
+	ULOC                int64      `json:"ULOC,omitempty"`
+	ComplexityHistogram []int64    `json:"ComplexityHistogram,omitempty"`
+	Files               []*FileJob `json:"Files,omitempty"`
+	lineLengthSum       int64
+	lineLengthLines     int64
+	sections            int64
+}
+
+// Total is the sum of every language, which is the Total row of the tabular output and Total in
+// the JSON output. It saves consumers from having to add up the languages. Skipped is the number
+// of files which were found but not counted and ULOC and Imports are only set when counted
+type Total struct {
+	Files             int64   `json:"Files"`
+	Lines             int64   `json:"Lines"`
+	Code              int64   `json:"Code"`
+	Comment           int64   `json:"Comment"`
+	Blank             int64   `json:"Blank"`
+	Complexity        int64   `json:"Complexity"`
+	Tokens            int64   `json:"Tokens"`
+	Skipped           int64   `json:"Skipped"`
+	ULOC              int64   `json:"ULOC,omitempty"`
+	Imports           int64   `json:"Imports,omitempty"`
+	AverageLines      float64 `json:"AverageLines"`
+	AverageComplexity float64 `json:"AverageComplexity"`
 }
 
 type OpenClose struct {
@@ -79,11 +147,18 @@ type OpenClose struct {
 }
 
 type CheckDuplicates struct {
-	hashes map[int64][][]byte
-	mux    sync.Mutex
+	hashes    map[int64][][]byte
+	locations map[duplicateKey][]string
+	mux       sync.Mutex
+}
+
+// Identifies the files which have the same content
+type duplicateKey struct {
+	key  int64
+	hash string
 }
 
-func (c *CheckDuplicates) Add(key int64, hash []byte) {
+func (c *CheckDuplicates) Add(key int64, hash []byte, location string) {
 	c.mux.Lock()
 	defer c.mux.Unlock()
 
@@ -93,6 +168,59 @@ func (c *CheckDuplicates) Add(key int64, hash []byte) {
 	} else {
 		c.hashes[key] = [][]byte{hash}
 	}
+
+	c.addLocation(key, hash, location)
+}
+
+// AddDuplicate records the location of a file which Check found to be a duplicate
+func (c *CheckDuplicates) AddDuplicate(key int64, hash []byte, location string) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.addLocation(key, hash, location)
+}
+
+func (c *CheckDuplicates) addLocation(key int64, hash []byte, location string) {
+	if c.locations == nil {
+		c.locations = map[duplicateKey][]string{}
+	}
+
+	k := duplicateKey{key: key, hash: string(hash)}
+	c.locations[k] = append(c.locations[k], location)
+}
+
+// Groups returns the locations of the files which share the same content where
+// there is more than one, with each group sorted and the groups sorted by first location.
+// The locations are formatted by PathStyle in the same way as the files which were counted
+func (c *CheckDuplicates) Groups() [][]string {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	groups := [][]string{}
+	for _, locations := range c.locations {
+		if len(locations) > 1 {
+			group := make([]string, len(locations))
+			for i, location := range locations {
+				group[i] = formatLocation(location)
+			}
+			sort.Strings(group)
+			groups = append(groups, group)
+		}
+	}
+
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i][0] < groups[j][0]
+	})
+
+	return groups
+}
+
+func (c *CheckDuplicates) Reset() {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	c.hashes = make(map[int64][][]byte)
+	c.locations = nil
 }
 
 func (c *CheckDuplicates) Check(key int64, hash []byte) bool {
@@ -111,6 +239,128 @@ func (c *CheckDuplicates) Check(key int64, hash []byte) bool {
 	return false
 }
 
+// Reasons a file which was found is not included in the counts
+const (
+	SkipBinary     = "binary"
+	SkipUnknown    = "unknown extension"
+	SkipReadError  = "read error"
+	SkipDuplicate  = "duplicate"
+	SkipLineLimits = "outside line limits"
+	SkipMinified   = "minified"
+	SkipFileSize   = "over max file size"
+	SkipGenerated  = "generated"
+	SkipNotInGit   = "not in git"
+	SkipUnchanged  = "unchanged since"
+)
+
+// SkippedFile is a file which was found but not included in the counts
+type SkippedFile struct {
+	Location string
+	Reason   string
+}
+
+// SkippedFiles collects the files which are skipped and needs to be safe
+// to add to from many GoRoutines
+type SkippedFiles struct {
+	files []SkippedFile
+	mux   sync.Mutex
+}
+
+func (s *SkippedFiles) Add(location string, reason string) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.files = append(s.files, SkippedFile{Location: location, Reason: reason})
+}
+
+// Files returns a copy of the skipped files sorted by location, with each location
+// formatted by PathStyle in the same way as the files which were counted
+func (s *SkippedFiles) Files() []SkippedFile {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	files := make([]SkippedFile, len(s.files))
+	copy(files, s.files)
+	for i := range files {
+		files[i].Location = formatLocation(files[i].Location)
+	}
+	sort.Slice(files, func(i, j int) bool {
+		return files[i].Location < files[j].Location
+	})
+
+	return files
+}
+
+func (s *SkippedFiles) Count() int64 {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	return int64(len(s.files))
+}
+
+func (s *SkippedFiles) Reset() {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+
+	s.files = nil
+}
+
+// UniqueLines collects the hash of every code line to count the unique lines of code
+// both in total and for each language and needs to be safe to add to from many GoRoutines
+type UniqueLines struct {
+	total     map[uint64]struct{}
+	languages map[string]map[uint64]struct{}
+	mux       sync.Mutex
+}
+
+// Add records the code line hashes of the file which are then released
+func (u *UniqueLines) Add(fileJob *FileJob) {
+	u.mux.Lock()
+	defer u.mux.Unlock()
+
+	if u.total == nil {
+		u.total = map[uint64]struct{}{}
+		u.languages = map[string]map[uint64]struct{}{}
+	}
+
+	language, ok := u.languages[fileJob.Language]
+	if !ok {
+		language = map[uint64]struct{}{}
+		u.languages[fileJob.Language] = language
+	}
+
+	for _, h := range fileJob.codeLineHashes {
+		u.total[h] = struct{}{}
+		language[h] = struct{}{}
+	}
+
+	fileJob.codeLineHashes = nil
+}
+
+// Total returns the number of unique code lines across every language
+func (u *UniqueLines) Total() int64 {
+	u.mux.Lock()
+	defer u.mux.Unlock()
+
+	return int64(len(u.total))
+}
+
+// Language returns the number of unique code lines for the language
+func (u *UniqueLines) Language(name string) int64 {
+	u.mux.Lock()
+	defer u.mux.Unlock()
+
+	return int64(len(u.languages[name]))
+}
+
+func (u *UniqueLines) Reset() {
+	u.mux.Lock()
+	defer u.mux.Unlock()
+
+	u.total = nil
+	u.languages = nil
+}
+
 type Trie struct {
 	Type  int
 	Close []byte
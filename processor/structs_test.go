@@ -1,6 +1,7 @@
 package processor
 
 import (
+	"reflect"
 	"testing"
 )
 
@@ -9,8 +10,8 @@ func CheckDuplicatesTest(t *testing.T) {
 		hashes: make(map[int64][][]byte),
 	}
 
-	c.Add(1, []byte("hash"))
-	c.Add(1, []byte("hash2"))
+	c.Add(1, []byte("hash"), "a.go")
+	c.Add(1, []byte("hash2"), "b.go")
 
 	if !c.Check(1, []byte("hash")) {
 		t.Error("Expected match")
@@ -28,3 +29,43 @@ func CheckDuplicatesTest(t *testing.T) {
 		t.Error("Expected no match")
 	}
 }
+
+func TestSkippedFiles(t *testing.T) {
+	s := SkippedFiles{}
+	s.Add("z.go", SkipBinary)
+	s.Add("a.go", SkipDuplicate)
+
+	files := s.Files()
+	if s.Count() != 2 || files[0].Location != "a.go" || files[0].Reason != SkipDuplicate {
+		t.Errorf("Unexpected skipped files %v", files)
+	}
+
+	s.Reset()
+	if s.Count() != 0 {
+		t.Error("Expected no skipped files after reset")
+	}
+}
+
+func TestCheckDuplicatesGroups(t *testing.T) {
+	c := CheckDuplicates{
+		hashes: make(map[int64][][]byte),
+	}
+
+	c.Add(1, []byte("hash"), "z.go")
+	c.AddDuplicate(1, []byte("hash"), "b.go")
+	c.Add(1, []byte("hash2"), "unique.go")
+	c.Add(2, []byte("hash"), "other.go")
+	c.AddDuplicate(2, []byte("hash"), "a.go")
+	c.AddDuplicate(2, []byte("hash"), "c.go")
+
+	groups := c.Groups()
+	expected := [][]string{{"a.go", "c.go", "other.go"}, {"b.go", "z.go"}}
+	if !reflect.DeepEqual(groups, expected) {
+		t.Errorf("Expected %v got %v", expected, groups)
+	}
+
+	c.Reset()
+	if len(c.Groups()) != 0 || c.Check(1, []byte("hash")) {
+		t.Error("Expected no duplicates after reset")
+	}
+}
@@ -0,0 +1,17 @@
+package processor
+
+import (
+	"os"
+
+	"golang.org/x/term"
+)
+
+// Returns the number of columns of the terminal the file is attached to or 0 if it is not a terminal
+func terminalWidth(file *os.File) int {
+	width, _, err := term.GetSize(int(file.Fd()))
+	if err != nil {
+		return 0
+	}
+
+	return width
+}
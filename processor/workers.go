@@ -1,11 +1,22 @@
 package processor
 
 import (
+	"bytes"
+	"context"
 	"crypto/md5"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
 	"fmt"
 	"hash"
-	"io/ioutil"
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"runtime/debug"
 	"sync"
+	"sync/atomic"
+	"unicode/utf16"
+	"unicode/utf8"
 )
 
 const (
@@ -17,6 +28,10 @@ const (
 	S_MULTICOMMENT_CODE  int64 = 6 // Indicates multi comment after code
 	S_MULTICOMMENT_BLANK int64 = 7 // Indicates multi comment ended with blank afterwards
 	S_STRING             int64 = 8
+	S_HEREDOC            int64 = 9
+	S_VERBATIM           int64 = 10
+	S_DOCSTRING          int64 = 11
+	S_HEREDOC_INDENTED   int64 = 12 // Indicates heredoc whose delimiter may be indented
 )
 
 type LineType int32
@@ -45,8 +60,10 @@ func checkForMatchSingle(currentByte byte, index int, endPoint int, matches []by
 	return false
 }
 
+// Lines made up of only these are blank no matter whether they are indented with tabs or
+// spaces. Form feeds are included as they are used as page breaks in C and Lisp sources
 func isWhitespace(currentByte byte) bool {
-	if currentByte != ' ' && currentByte != '\t' && currentByte != '\n' && currentByte != '\r' {
+	if currentByte != ' ' && currentByte != '\t' && currentByte != '\n' && currentByte != '\r' && currentByte != '\f' && currentByte != '\v' {
 		return false
 	}
 
@@ -75,6 +92,14 @@ func resetState(currentState int64) int64 {
 		currentState = S_MULTICOMMENT
 	} else if currentState == S_STRING {
 		currentState = S_STRING
+	} else if currentState == S_HEREDOC {
+		currentState = S_HEREDOC
+	} else if currentState == S_HEREDOC_INDENTED {
+		currentState = S_HEREDOC_INDENTED
+	} else if currentState == S_VERBATIM {
+		currentState = S_VERBATIM
+	} else if currentState == S_DOCSTRING {
+		currentState = S_DOCSTRING
 	} else {
 		currentState = S_BLANK
 	}
@@ -102,6 +127,223 @@ func stringState(fileJob *FileJob, index int, endPoint int, stringTrie *Trie, en
 	return index, currentState
 }
 
+// Only the close of the docstring which was opened ends it so a " inside """ does not
+func docstringState(fileJob *FileJob, index int, endPoint int, endString []byte, currentState int64) (int, int64) {
+	for i := index; i < endPoint; i++ {
+		index = i
+
+		if fileJob.Content[i] == '\n' {
+			return i, currentState
+		}
+
+		if fileJob.Content[i-1] != '\\' && bytes.HasPrefix(fileJob.Content[i:], endString) {
+			return i + len(endString) - 1, S_CODE
+		}
+	}
+
+	return index, currentState
+}
+
+func isDocstring(endString []byte, langFeatures LanguageFeature) bool {
+	for _, docstring := range langFeatures.Docstrings {
+		if bytes.Equal(endString, docstring) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Returns true if the line of code is an import. A line which opens an import block such as
+// Go's import ( sets blockClose so the lines up to and including the close are imports too
+func isImportLine(line []byte, langFeatures LanguageFeature, blockClose *[]byte) bool {
+	line = bytes.TrimSpace(line)
+
+	if *blockClose != nil {
+		if bytes.HasPrefix(line, *blockClose) {
+			*blockClose = nil
+		}
+		return true
+	}
+
+	for _, block := range langFeatures.ImportBlocks {
+		if bytes.HasPrefix(line, block.Open) {
+			if !bytes.Contains(line[len(block.Open):], block.Close) {
+				*blockClose = block.Close
+			}
+			return true
+		}
+	}
+
+	for _, prefix := range langFeatures.Imports {
+		if bytes.HasPrefix(line, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Verbatim and raw strings end at the first match of the close other than where the language
+// has an escape for verbatim strings. In C# it is the quote itself so "" is a quote in @"a""b"
+// while in Python a backslash keeps the quote after it from ending r"\"" as in other strings
+func verbatimState(fileJob *FileJob, index int, endPoint int, endString []byte, escape byte, currentState int64) (int, int64) {
+	for i := index; i <= endPoint; i++ {
+		index = i
+
+		if fileJob.Content[i] == '\n' {
+			return i, currentState
+		}
+
+		if escape != 0 && fileJob.Content[i] == escape && i < endPoint {
+			if escape != endString[0] {
+				i++
+				continue
+			}
+
+			if bytes.HasPrefix(fileJob.Content[i+1:], endString) {
+				i += len(endString)
+				continue
+			}
+		}
+
+		if bytes.HasPrefix(fileJob.Content[i:], endString) {
+			return i + len(endString) - 1, S_CODE
+		}
+	}
+
+	return index, currentState
+}
+
+// Reads the delimiter of a raw string such as R"delim( returning the index of the opening
+// parenthesis and the close of the string which is )delim"
+func rawStringDelimiter(fileJob *FileJob, index int, endPoint int) (int, []byte) {
+	for i := index; i <= endPoint && i-index <= 16; i++ {
+		switch fileJob.Content[i] {
+		case '(':
+			endString := append([]byte{')'}, fileJob.Content[index:i]...)
+			return i, append(endString, '"')
+		case ')', '\\', ' ', '\t', '\n', '\r', '"':
+			return index, nil
+		}
+	}
+
+	return index, nil
+}
+
+// Checks the token starts at the beginning of a word as prefixes such as r in r"..."
+// could otherwise match the end of an identifier
+func isTokenStart(fileJob *FileJob, index int) bool {
+	return index == 0 || !isIdentifier(fileJob.Content[index-1], false)
+}
+
+// Identifiers are used as heredoc delimiters and may not start with a digit
+func isIdentifier(currentByte byte, first bool) bool {
+	if currentByte == '_' || (currentByte >= 'a' && currentByte <= 'z') || (currentByte >= 'A' && currentByte <= 'Z') {
+		return true
+	}
+
+	return !first && currentByte >= '0' && currentByte <= '9'
+}
+
+func skipToLineEnd(fileJob *FileJob, index int, endPoint int) int {
+	for index < endPoint && fileJob.Content[index] != '\n' {
+		index++
+	}
+
+	if index > endPoint {
+		return endPoint
+	}
+
+	return index
+}
+
+// Reads the delimiter which follows a heredoc marker such as EOF in <<EOF, << EOF, <<- 'EOF' or
+// <<<"EOF" returning the index after it. If there is no delimiter the marker was something else
+// such as a shift operator so the returned delimiter is nil. As a plain << is also the shift in
+// $((1 << n)) an unquoted delimiter after it has to be the end of the command
+func heredocDelimiter(fileJob *FileJob, index int, endPoint int, marker []byte) (int, []byte) {
+	i := skipBlanks(fileJob, index, endPoint)
+
+	quote := byte(0)
+	if i <= endPoint && (fileJob.Content[i] == '\'' || fileJob.Content[i] == '"') {
+		quote = fileJob.Content[i]
+		i++
+	}
+
+	start := i
+	for i <= endPoint && isIdentifier(fileJob.Content[i], i == start) {
+		i++
+	}
+
+	if i == start {
+		return index, nil
+	}
+	delimiter := fileJob.Content[start:i]
+
+	if quote != 0 {
+		if i > endPoint || fileJob.Content[i] != quote {
+			return index, nil
+		}
+		i++
+	} else if string(marker) == "<<" && !endsCommand(fileJob, i, endPoint) {
+		return index, nil
+	}
+
+	return i, delimiter
+}
+
+// Returns true if nothing but the end of the line or a redirection, pipe or list operator such
+// as > or | or ; follows the index, which is what comes after a heredoc delimiter in a command
+// but never after the right hand side of a shift such as in $((1 << n)) or $((n << 2 + 1))
+func endsCommand(fileJob *FileJob, index int, endPoint int) bool {
+	i := skipBlanks(fileJob, index, endPoint)
+	if i > endPoint {
+		return true
+	}
+
+	switch fileJob.Content[i] {
+	case '\n', '\r', '#', '<', '>', '|', '&', ';':
+		return true
+	}
+
+	// A redirection of another file descriptor such as 2>/dev/null
+	return fileJob.Content[i] >= '0' && fileJob.Content[i] <= '9'
+}
+
+// Returns the index of the first byte from the index which is not a space or tab
+func skipBlanks(fileJob *FileJob, index int, endPoint int) int {
+	for index <= endPoint && (fileJob.Content[index] == ' ' || fileJob.Content[index] == '\t') {
+		index++
+	}
+
+	return index
+}
+
+// Only a plain << needs its closing delimiter at the start of the line. The others
+// such as <<- and <<~ or PHP's <<< allow it to be indented
+func heredocStart(marker []byte) int64 {
+	if string(marker) == "<<" {
+		return S_HEREDOC
+	}
+	return S_HEREDOC_INDENTED
+}
+
+// The body of a heredoc is counted as code no matter what it contains until a line
+// starting with the delimiter. Leading whitespace is skipped before this is called
+// so for S_HEREDOC the delimiter is only matched when nothing was skipped
+func heredocState(fileJob *FileJob, index int, endPoint int, endString []byte, currentState int64) (int, int64) {
+	indented := index != 0 && fileJob.Content[index-1] != '\n'
+	if (currentState == S_HEREDOC_INDENTED || !indented) && bytes.HasPrefix(fileJob.Content[index:], endString) {
+		end := index + len(endString)
+		if end > endPoint || !isIdentifier(fileJob.Content[end], false) {
+			return end - 1, S_CODE
+		}
+	}
+
+	return skipToLineEnd(fileJob, index, endPoint), currentState
+}
+
 func codeState(
 	fileJob *FileJob,
 	index int,
@@ -134,11 +376,28 @@ func codeState(
 				(*digest).Write(digestible)
 			}
 
-			switch tokenType, offsetJump, endString := langFeatures.Tokens.Match(fileJob.Content[i:]); tokenType {
+			tokenType, offsetJump, endString := langFeatures.Tokens.Match(fileJob.Content[i:])
+			if tokenType != 0 {
+				fileJob.Tokens++
+			}
+
+			switch tokenType {
 			case T_STRING:
 				currentState = S_STRING
 				return i, currentState, endString, endComments
 
+			case T_VERBATIM:
+				if isTokenStart(fileJob, i) {
+					return i + offsetJump - 1, S_VERBATIM, endString, endComments
+				}
+
+			case T_RAWSTRING:
+				if isTokenStart(fileJob, i) {
+					if end, rawEnd := rawStringDelimiter(fileJob, i+offsetJump, endPoint); rawEnd != nil {
+						return end, S_VERBATIM, rawEnd, endComments
+					}
+				}
+
 			case T_SLCOMMENT:
 				currentState = S_COMMENT_CODE
 				return i, currentState, endString, endComments
@@ -154,6 +413,15 @@ func codeState(
 			case T_COMPLEXITY:
 				if index == 0 || isWhitespace(fileJob.Content[index-1]) {
 					fileJob.Complexity++
+					if traceStates {
+						traceState(fileJob, i, fmt.Sprintf("matched complexity %q", fileJob.Content[i:i+offsetJump]))
+					}
+				}
+
+			case T_HEREDOC:
+				// The heredoc starts on the next line so the rest of this one is skipped
+				if end, delimiter := heredocDelimiter(fileJob, i+offsetJump, endPoint, fileJob.Content[i:i+offsetJump]); delimiter != nil {
+					return skipToLineEnd(fileJob, end, endPoint), heredocStart(fileJob.Content[i : i+offsetJump]), delimiter, endComments
 				}
 			}
 		}
@@ -213,7 +481,12 @@ func blankState(
 	endString []byte,
 	langFeatures LanguageFeature,
 ) (int, int64, []byte, [][]byte) {
-	switch tokenType, offsetJump, endString := langFeatures.Tokens.Match(fileJob.Content[index:]); tokenType {
+	tokenType, offsetJump, endString := langFeatures.Tokens.Match(fileJob.Content[index:])
+	if tokenType != 0 {
+		fileJob.Tokens++
+	}
+
+	switch tokenType {
 	case T_MLCOMMENT:
 		if langFeatures.Nested || len(endComments) == 0 {
 			endComments = append(endComments, endString)
@@ -230,12 +503,30 @@ func blankState(
 		currentState = S_STRING
 		return index, currentState, endString, endComments
 
+	case T_VERBATIM:
+		return index + offsetJump - 1, S_VERBATIM, endString, endComments
+
+	case T_RAWSTRING:
+		if end, rawEnd := rawStringDelimiter(fileJob, index+offsetJump, endPoint); rawEnd != nil {
+			return end, S_VERBATIM, rawEnd, endComments
+		}
+		currentState = S_CODE
+
 	case T_COMPLEXITY:
 		currentState = S_CODE
 		if index == 0 || isWhitespace(fileJob.Content[index-1]) {
 			fileJob.Complexity++
+			if traceStates {
+				traceState(fileJob, index, fmt.Sprintf("matched complexity %q", fileJob.Content[index:index+offsetJump]))
+			}
 		}
 
+	case T_HEREDOC:
+		if end, delimiter := heredocDelimiter(fileJob, index+offsetJump, endPoint, fileJob.Content[index:index+offsetJump]); delimiter != nil {
+			return skipToLineEnd(fileJob, end, endPoint), heredocStart(fileJob.Content[index : index+offsetJump]), delimiter, endComments
+		}
+		currentState = S_CODE
+
 	default:
 		currentState = S_CODE
 	}
@@ -243,10 +534,70 @@ func blankState(
 	return index, currentState, endString, endComments
 }
 
+// Replaces each lone \r used by classic Mac OS to end lines with \n so that every line ends
+// with \n. A \r followed by \n is left alone as \r is treated as whitespace. The content is
+// copied when changed as it may be memory mapped and so cannot be written to
+func normalizeLineEndings(content []byte) []byte {
+	index := bytes.IndexByte(content, '\r')
+	for index != -1 && index+1 < len(content) && content[index+1] == '\n' {
+		next := bytes.IndexByte(content[index+1:], '\r')
+		if next == -1 {
+			return content
+		}
+		index += next + 1
+	}
+
+	if index == -1 {
+		return content
+	}
+
+	normalized := make([]byte, len(content))
+	copy(normalized, content)
+	for i := index; i < len(normalized); i++ {
+		if normalized[i] == '\r' && (i+1 == len(normalized) || normalized[i+1] != '\n') {
+			normalized[i] = '\n'
+		}
+	}
+
+	return normalized
+}
+
+// Counts the lines of the fileJob without tokenising it for when only lines are wanted
+// which follows CountStats in that a final line without a newline is still a line
+func countLines(fileJob *FileJob) {
+	fileJob.Bytes = int64(len(fileJob.Content))
+	if fileJob.Bytes == 0 {
+		fileJob.Lines = 0
+		return
+	}
+
+	if !DisableCheckBinary && bytes.IndexByte(fileJob.Content[:min(len(fileJob.Content), 10000)], 0) != -1 {
+		fileJob.Binary = true
+		return
+	}
+
+	fileJob.Content = normalizeLineEndings(fileJob.Content)
+	fileJob.Lines = int64(bytes.Count(fileJob.Content, []byte{'\n'}))
+	if fileJob.Content[len(fileJob.Content)-1] != '\n' {
+		fileJob.Lines++
+	}
+
+	if Duplicates {
+		digest := newDuplicateHash()
+		digest.Write(fileJob.Content)
+		fileJob.Hash = digest.Sum(nil)
+	}
+
+	fileJob.Content = nil
+}
+
 // CountStats will process the fileJob
 // If the file contains anything even just a newline its line count should be >= 1.
 // If the file has a size of 0 its line count should be 0.
 // Newlines belong to the line they started on so a file of \n means only 1 line
+// A final line without a newline is still a line so a\nb and a\nb\n are both 2 lines
+// which differs from wc -l that only counts newlines and would say 1 for the first
+// Lines can end with \n, \r\n or \r alone and files mixing them are counted the same
 // This is the 'hot' path for the application and needs to be as fast as possible
 func CountStats(fileJob *FileJob) {
 
@@ -257,6 +608,7 @@ func CountStats(fileJob *FileJob) {
 		return
 	}
 
+	fileJob.Content = normalizeLineEndings(fileJob.Content)
 	langFeatures := LanguageFeatures[fileJob.Language]
 
 	if langFeatures.Complexity == nil {
@@ -277,6 +629,8 @@ func CountStats(fileJob *FileJob) {
 
 	endPoint := int(fileJob.Bytes - 1)
 	currentState := S_BLANK
+	// Stack of the close tokens for the open multiline comments. For languages which support
+	// nesting its length is the depth and the comment only ends once it is empty again
 	endComments := [][]byte{}
 	endString := []byte{}
 
@@ -284,12 +638,33 @@ func CountStats(fileJob *FileJob) {
 	// the byte array here is to avoid GC pressure. MD5 is in the standard library
 	// and is fast enough to not warrant murmur3 hashing. No need to be
 	// crypto secure here either so no need to eat the performance cost of a better
-	// hash method
+	// hash method unless asked for using DuplicateHash
 	var digest hash.Hash
 	if Duplicates {
-		digest = md5.New()
+		digest = newDuplicateHash()
 	}
 
+	// Each code line is hashed after trimming so identical lines can be counted once
+	// across all files. FNV is used as it is fast and the hashes are kept in memory
+	var lineDigest hash.Hash64
+	if ULOC {
+		lineDigest = fnv.New64a()
+	}
+	lineStart := 0
+
+	// A docstring is a string which is the first statement of a block so the last byte of
+	// the previous code line is kept to check for the : that starts one. The start of
+	// the file is treated as the start of a block
+	docstrings := Docstrings && len(langFeatures.Docstrings) != 0
+	lastCode := byte(':')
+
+	// Import lines are counted as imports rather than code when asked for. The close of
+	// the import block the previous lines are in is kept as blocks span many lines, and the
+	// state the line started in is kept so a line inside a string is never an import
+	imports := Imports && (len(langFeatures.Imports) != 0 || len(langFeatures.ImportBlocks) != 0)
+	var importClose []byte
+	lineStartState := currentState
+
 	for index := 0; index < len(fileJob.Content); index++ {
 
 		// Based on our current state determine if the state should change by checking
@@ -297,6 +672,7 @@ func CountStats(fileJob *FileJob) {
 		// changing anything in here and profile/measure afterwards!
 		// NB that the order of the if statements matters and has been set to what in benchmarks is most efficient
 		if !isWhitespace(fileJob.Content[index]) {
+			previousState := currentState
 
 			switch currentState {
 			case S_CODE:
@@ -312,6 +688,15 @@ func CountStats(fileJob *FileJob) {
 				)
 			case S_STRING:
 				index, currentState = stringState(fileJob, index, endPoint, langFeatures.Strings, endString, currentState)
+			case S_DOCSTRING:
+				// Once the docstring closes the line is a comment unless code follows
+				if index, currentState = docstringState(fileJob, index, endPoint, endString, currentState); currentState == S_CODE {
+					currentState = S_MULTICOMMENT_BLANK
+				}
+			case S_HEREDOC, S_HEREDOC_INDENTED:
+				index, currentState = heredocState(fileJob, index, endPoint, endString, currentState)
+			case S_VERBATIM:
+				index, currentState = verbatimState(fileJob, index, endPoint, endString, langFeatures.VerbatimEscape, currentState)
 			case S_MULTICOMMENT, S_MULTICOMMENT_CODE:
 				index, currentState, endString, endComments = commentState(
 					fileJob,
@@ -334,6 +719,17 @@ func CountStats(fileJob *FileJob) {
 					endString,
 					langFeatures,
 				)
+
+				// The string is left at its first byte so the rest of the open is skipped
+				if currentState == S_STRING && docstrings && lastCode == ':' && isDocstring(endString, langFeatures) {
+					index += len(endString) - 1
+					currentState = S_DOCSTRING
+				}
+			}
+
+			// The offset is the last byte looked at which for a token is where it ends
+			if traceStates && currentState != previousState {
+				traceState(fileJob, index, fmt.Sprintf("%s -> %s", stateNames[previousState], stateNames[currentState]))
 			}
 		}
 
@@ -344,6 +740,10 @@ func CountStats(fileJob *FileJob) {
 		// This means the end of processing the line so calculate the stats according to what state
 		// we are currently in
 		if fileJob.Content[index] == '\n' || index >= endPoint {
+			if traceStates {
+				traceState(fileJob, index, fmt.Sprintf("end of line in state %s", stateNames[currentState]))
+			}
+
 			fileJob.Lines++
 
 			if Trace {
@@ -351,15 +751,29 @@ func CountStats(fileJob *FileJob) {
 			}
 
 			switch currentState {
-			case S_CODE, S_STRING, S_COMMENT_CODE, S_MULTICOMMENT_CODE:
-				fileJob.Code++
+			case S_CODE, S_STRING, S_HEREDOC, S_HEREDOC_INDENTED, S_VERBATIM, S_COMMENT_CODE, S_MULTICOMMENT_CODE:
+				if imports && (lineStartState == S_BLANK || lineStartState == S_CODE) && isImportLine(fileJob.Content[lineStart:index+1], langFeatures, &importClose) {
+					fileJob.Imports++
+				} else {
+					fileJob.Code++
+				}
 				currentState = resetState(currentState)
+				if docstrings {
+					if line := bytes.TrimSpace(fileJob.Content[lineStart : index+1]); len(line) != 0 {
+						lastCode = line[len(line)-1]
+					}
+				}
+				if ULOC {
+					lineDigest.Reset()
+					lineDigest.Write(bytes.TrimSpace(fileJob.Content[lineStart : index+1]))
+					fileJob.codeLineHashes = append(fileJob.codeLineHashes, lineDigest.Sum64())
+				}
 				if fileJob.Callback != nil {
 					if !fileJob.Callback.ProcessLine(fileJob, fileJob.Lines, LINE_CODE) {
 						return
 					}
 				}
-			case S_COMMENT, S_MULTICOMMENT, S_MULTICOMMENT_BLANK:
+			case S_COMMENT, S_MULTICOMMENT, S_MULTICOMMENT_BLANK, S_DOCSTRING:
 				fileJob.Comment++
 				currentState = resetState(currentState)
 				if fileJob.Callback != nil {
@@ -375,6 +789,9 @@ func CountStats(fileJob *FileJob) {
 					}
 				}
 			}
+
+			lineStart = index + 1
+			lineStartState = currentState
 		}
 	}
 
@@ -386,8 +803,125 @@ func CountStats(fileJob *FileJob) {
 	fileJob.Content = nil
 }
 
+// The hashes which can be used to detect duplicate files
+var duplicateHashes = map[string]func() hash.Hash{
+	"fnv":    func() hash.Hash { return fnv.New128a() },
+	"md5":    md5.New,
+	"sha256": sha256.New,
+}
+
+// Returns the hash used to detect duplicate files as set by DuplicateHash
+// falling back to MD5 if it is not one of the known hashes
+func newDuplicateHash() hash.Hash {
+	if h, ok := duplicateHashes[DuplicateHash]; ok {
+		return h()
+	}
+
+	return md5.New()
+}
+
+// Returned when reading a file larger than MaxFileSize so that it is skipped rather than counted
+var errOverMaxFileSize = errors.New("file is over the max file size")
+
+func isOverMaxFileSize(size int64) bool {
+	return MaxFileSize > 0 && size > MaxFileSize
+}
+
+// Reads the content of the file into the job. Files of at least MmapThreshold bytes are
+// memory mapped instead which avoids copying them onto the heap, falling back to reading
+// them if that fails. Mapped content must be released with releaseContent once counted
+func readFileContent(res *FileJob) error {
+	file, err := os.Open(res.Location)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	info, err := file.Stat()
+	if err != nil {
+		return err
+	}
+
+	if isOverMaxFileSize(info.Size()) {
+		return errOverMaxFileSize
+	}
+
+	res.ModTime = fileModTime(info.ModTime())
+
+	if MmapThreshold > 0 && info.Size() >= MmapThreshold {
+		content, unmap, err := mapFile(file)
+		if err == nil {
+			res.Content = content
+			res.unmap = unmap
+			return nil
+		}
+
+		if Verbose {
+			printWarn(fmt.Sprintf("unable to mmap falling back to reading: %s %s", res.Location, err))
+		}
+	}
+
+	var buffer bytes.Buffer
+	buffer.Grow(int(info.Size()) + bytes.MinRead)
+	if _, err := buffer.ReadFrom(file); err != nil {
+		return err
+	}
+	res.Content = buffer.Bytes()
+
+	return nil
+}
+
+// Byte order marks which identify the encoding of a file
+var (
+	bomUTF8    = []byte{0xEF, 0xBB, 0xBF}
+	bomUTF16LE = []byte{0xFF, 0xFE}
+	bomUTF16BE = []byte{0xFE, 0xFF}
+)
+
+// Decodes content starting with a UTF-16 byte order mark into UTF-8 and removes a UTF-8
+// byte order mark, so the state machine which works on bytes always sees UTF-8 rather
+// than UTF-16 where every other byte is null and the file looks binary
+func decodeContent(content []byte) []byte {
+	switch {
+	case bytes.HasPrefix(content, bomUTF8):
+		return content[len(bomUTF8):]
+	case bytes.HasPrefix(content, bomUTF16LE):
+		return decodeUTF16(content[len(bomUTF16LE):], binary.LittleEndian)
+	case bytes.HasPrefix(content, bomUTF16BE):
+		return decodeUTF16(content[len(bomUTF16BE):], binary.BigEndian)
+	}
+
+	return content
+}
+
+// Converts UTF-16 to UTF-8 ignoring any odd byte left at the end
+func decodeUTF16(content []byte, order binary.ByteOrder) []byte {
+	units := make([]uint16, len(content)/2)
+	for i := range units {
+		units[i] = order.Uint16(content[i*2:])
+	}
+
+	decoded := make([]byte, 0, len(units))
+	buffer := make([]byte, utf8.UTFMax)
+	for _, r := range utf16.Decode(units) {
+		size := utf8.EncodeRune(buffer, r)
+		decoded = append(decoded, buffer[:size]...)
+	}
+
+	return decoded
+}
+
+// Unmaps the content of the job if it was memory mapped as it cannot be used after
+func releaseContent(res *FileJob) {
+	if res.unmap != nil {
+		res.unmap()
+		res.unmap = nil
+		res.Content = nil
+	}
+}
+
 // Reads entire file into memory and then pushes it onto the next queue
-func fileReaderWorker(input chan *FileJob, output chan *FileJob) {
+func fileReaderWorker(ctx context.Context, input chan *FileJob, output chan *FileJob) {
 	var startTime int64 = 0
 	var wg sync.WaitGroup
 
@@ -395,24 +929,67 @@ func fileReaderWorker(input chan *FileJob, output chan *FileJob) {
 		wg.Add(1)
 		go func() {
 			for res := range input {
+				if ctx.Err() != nil {
+					break
+				}
+
 				if startTime == 0 {
 					startTime = makeTimestampMilli()
 				}
 
+				// Files from archives are read when the archive is walked
+				if res.Content != nil {
+					for _, job := range embeddedJobs(res) {
+						select {
+						case output <- job:
+						case <-ctx.Done():
+						}
+					}
+					continue
+				}
+
+				if res.Language == SheBang {
+					language, ok := orUnknown(detectSheBangFile(res.Location))
+
+					if !ok {
+						if Verbose {
+							printWarn(fmt.Sprintf("skipping file unknown extension: %s", res.Filename))
+						}
+						skipped.Add(res.Location, SkipUnknown)
+						continue
+					}
+
+					res.Language = language
+				}
+
 				fileStartTime := makeTimestampNano()
-				content, err := ioutil.ReadFile(res.Location)
+				err := readFileContent(res)
 
 				if Trace {
 					printTrace(fmt.Sprintf("nanoseconds read into memory: %s: %d", res.Location, makeTimestampNano()-fileStartTime))
 				}
 
 				if err == nil {
-					res.Content = content
-					output <- res
+					res.Content = decodeContent(res.Content)
+
+					for _, job := range embeddedJobs(res) {
+						select {
+						case output <- job:
+						case <-ctx.Done():
+							releaseContent(job)
+						}
+					}
+				} else if err == errOverMaxFileSize {
+					if Verbose {
+						printWarn(fmt.Sprintf("skipping file over max file size: %s", res.Location))
+					}
+					skipped.Add(res.Location, SkipFileSize)
 				} else {
 					if Verbose {
 						printWarn(fmt.Sprintf("error reading: %s %s", res.Location, err))
 					}
+					skipped.Add(res.Location, SkipReadError)
+					readErrors.Add(res.Location, err.Error())
 				}
 			}
 
@@ -420,44 +997,229 @@ func fileReaderWorker(input chan *FileJob, output chan *FileJob) {
 		}()
 	}
 
+	// Nothing is read after the output is closed as the run is finished once it has been drained
 	go func() {
 		wg.Wait()
-		close(output)
 
 		if Debug {
 			printDebug(fmt.Sprintf("milliseconds reading files into memory: %d", makeTimestampMilli()-startTime))
 		}
+
+		close(output)
 	}()
 }
 
+// Added to the language of test files when Tests is set so they are counted separately
+const TestsLanguageSuffix = " (tests)"
+
+// Returns true if the file name matches one of the test file patterns of its language
+// such as *_test.go for Go or test_*.py for Python
+func isTestFile(fileJob *FileJob) bool {
+	for _, pattern := range LanguageFeatures[fileJob.Language].TestFiles {
+		if matched, _ := filepath.Match(pattern, fileJob.Filename); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Measures the length in characters of each line of the file, not including the newline, for
+// LineLength. Needs to be called before the file is counted as the content is released after
+func measureLineLengths(fileJob *FileJob) {
+	content := normalizeLineEndings(fileJob.Content)
+
+	for len(content) != 0 {
+		line := content
+		if index := bytes.IndexByte(content, '\n'); index != -1 {
+			line, content = content[:index], content[index+1:]
+		} else {
+			content = nil
+		}
+
+		length := int64(utf8.RuneCount(bytes.TrimSuffix(line, []byte("\r"))))
+		fileJob.lineLengthSum += length
+		fileJob.lineLengthLines++
+		if length > fileJob.MaxLineLength {
+			fileJob.MaxLineLength = length
+		}
+	}
+
+	fileJob.AverageLineLength = average(fileJob.lineLengthSum, fileJob.lineLengthLines)
+}
+
+// The pseudo language minified files are counted under when Minified is set
+const MinifiedLanguage = "Minified"
+
+// Identifies minified files such as *.min.js which are mostly one very long line of code
+// by the average bytes per line being over MinifiedLineLength
+func isMinified(fileJob *FileJob) bool {
+	if fileJob.Lines == 0 {
+		return false
+	}
+
+	return fileJob.Bytes/fileJob.Lines > MinifiedLineLength
+}
+
+// How many lines from the top of a file are checked for the markers of a generated file
+var generatedLines = 20
+
+// Records which of the first generatedLines lines of a file are comments
+type commentLines map[int64]bool
+
+func (c commentLines) ProcessLine(job *FileJob, currentLine int64, lineType LineType) bool {
+	if lineType == LINE_COMMENT {
+		c[currentLine] = true
+	}
+
+	return currentLine < int64(generatedLines)
+}
+
+// Identifies generated files by one of GeneratedMarkers being in a comment line in the
+// first few lines which covers the Go convention of "// Code generated ... DO NOT EDIT."
+// and the similar comments written by protoc, thrift, T4 and others. Only comment lines
+// are checked so code such as msg := "do not edit" does not mark a file as generated
+func isGenerated(fileJob *FileJob) bool {
+	markers := make([][]byte, 0, len(GeneratedMarkers))
+	for _, marker := range GeneratedMarkers {
+		markers = append(markers, bytes.ToLower([]byte(marker)))
+	}
+
+	head := fileJob.Content
+	end := 0
+	for i := 0; i < generatedLines && end < len(head); i++ {
+		index := bytes.IndexByte(head[end:], '\n')
+		if index == -1 {
+			end = len(head)
+			break
+		}
+		end += index + 1
+	}
+	head = head[:end]
+
+	// Run the head through the same state machine used for counting so multiline
+	// comments and strings are understood the same way they are everywhere else
+	comments := commentLines{}
+	CountStats(&FileJob{
+		Language: fileJob.Language,
+		Location: fileJob.Location,
+		Content:  head,
+		Callback: comments,
+	})
+
+	content := head
+	for i := int64(1); len(content) != 0; i++ {
+		line := content
+		if index := bytes.IndexByte(content, '\n'); index != -1 {
+			line, content = content[:index], content[index+1:]
+		} else {
+			content = nil
+		}
+
+		if !comments[i] {
+			continue
+		}
+
+		lower := bytes.ToLower(line)
+		for _, marker := range markers {
+			if bytes.Contains(lower, marker) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// Checks the total lines of a file, including blank and comment lines, are within
+// MinLines and MaxLines where a MaxLines of 0 means there is no maximum
+func withinLineLimits(lines int64) bool {
+	if lines < MinLines {
+		return false
+	}
+
+	return MaxLines == 0 || lines <= MaxLines
+}
+
+// Files which were found but not counted so the user can see why
+var skipped = SkippedFiles{}
+
+// Files which could not be read with the error as the reason which are reported when Strict is set
+var readErrors = SkippedFiles{}
+
+// The hashes of the code lines of every processed file when ULOC is set
+var uniqueLines = UniqueLines{}
+
 var duplicates = CheckDuplicates{
 	hashes: make(map[int64][][]byte),
 }
 
 // Does the actual processing of stats and as such contains the hot path CPU call
-func fileProcessorWorker(input chan *FileJob, output chan *FileJob) {
+func fileProcessorWorker(ctx context.Context, input chan *FileJob, output chan *FileJob) {
 	var startTime int64 = 0
+	var fileCount int64
+	var resetGc sync.Once
 	var wg sync.WaitGroup
 	for i := 0; i < FileProcessJobWorkers; i++ {
 		wg.Add(1)
 		go func() {
 			for res := range input {
+				if ctx.Err() != nil {
+					break
+				}
+
 				if startTime == 0 {
 					startTime = makeTimestampMilli()
 				}
 
 				fileStartTime := makeTimestampNano()
-				CountStats(res)
 
-				if Duplicates {
+				// Turn GC back to what it was before once enough files have been read, which is
+				// counted here so it happens whichever way the files were found. Only needed if
+				// ConfigureGc turned it off as it is not when used as a library
+				if gcPercent >= 0 && atomic.AddInt64(&fileCount, 1) >= int64(GcFileCount) {
+					resetGc.Do(func() {
+						debug.SetGCPercent(gcPercent)
+					})
+				}
+
+				// Checked first as the content is no longer available once counted
+				generated := NoGen && isGenerated(res)
+				if LineLength {
+					measureLineLengths(res)
+				}
+				var blameErr error
+				if LinesOnly {
+					countLines(res)
+				} else if Since != "" {
+					blameErr = countStatsSince(ctx, res)
+				} else if res.Language == JupyterLanguage {
+					countNotebook(res)
+				} else {
+					CountStats(res)
+				}
+				releaseContent(res)
+
+				if blameErr != nil {
+					if Verbose {
+						printWarn(fmt.Sprintf("skipping file unable to git blame: %s %s", res.Location, blameErr))
+					}
+					skipped.Add(res.Location, SkipNotInGit)
+					continue
+				}
+
+				// Sections of a file share its location and may share content with other files
+				// so whether the file is a duplicate cannot be told from any one of them
+				if Duplicates && res.sectionFile == nil {
 					if duplicates.Check(res.Bytes, res.Hash) {
 						if Verbose {
 							printWarn(fmt.Sprintf("skipping duplicate file: %s", res.Location))
 						}
-						wg.Done()
-						return
+						skipped.Add(res.Location, SkipDuplicate)
+						duplicates.AddDuplicate(res.Bytes, res.Hash, res.Location)
+						continue
 					} else {
-						duplicates.Add(res.Bytes, res.Hash)
+						duplicates.Add(res.Bytes, res.Hash, res.Location)
 					}
 				}
 
@@ -465,12 +1227,54 @@ func fileProcessorWorker(input chan *FileJob, output chan *FileJob) {
 					printTrace(fmt.Sprintf("nanoseconds process: %s: %d", res.Location, makeTimestampNano()-fileStartTime))
 				}
 
-				if !res.Binary {
-					output <- res
-				} else {
+				if res.Binary {
 					if Verbose {
 						printWarn(fmt.Sprintf("skipping file identified as binary: %s", res.Location))
 					}
+					skipped.Add(res.Location, SkipBinary)
+				} else if !withinLineLimits(res.Lines) {
+					if Verbose {
+						printWarn(fmt.Sprintf("skipping file due to line count %d outside limits: %s", res.Lines, res.Location))
+					}
+					skipped.Add(res.Location, SkipLineLimits)
+				} else if NoMinified && isMinified(res) {
+					if Verbose {
+						printWarn(fmt.Sprintf("skipping file identified as minified: %s", res.Location))
+					}
+					skipped.Add(res.Location, SkipMinified)
+				} else if generated {
+					if Verbose {
+						printWarn(fmt.Sprintf("skipping file identified as generated: %s", res.Location))
+					}
+					skipped.Add(res.Location, SkipGenerated)
+				} else if Since != "" && res.Lines == 0 {
+					if Verbose {
+						printWarn(fmt.Sprintf("skipping file unchanged since %s: %s", Since, res.Location))
+					}
+					skipped.Add(res.Location, SkipUnchanged)
+				} else {
+					if Minified && isMinified(res) {
+						res.Language = MinifiedLanguage
+					} else if Tests && isTestFile(res) {
+						res.Language += TestsLanguageSuffix
+					}
+
+					if ULOC {
+						uniqueLines.Add(res)
+					}
+
+					// Whichever section of a split file gets here first counts as the file so
+					// it is still counted once when its other sections are skipped
+					if res.sectionFile != nil {
+						res.extraSection = !atomic.CompareAndSwapInt32(res.sectionFile, 0, 1)
+					}
+
+					res.Location = formatLocation(res.Location)
+
+					select {
+					case output <- res:
+					case <-ctx.Done():
+					}
 				}
 			}
 
@@ -478,12 +1282,13 @@ func fileProcessorWorker(input chan *FileJob, output chan *FileJob) {
 		}()
 	}
 
+	// The settings are read before the output is closed as a run from ProcessResultsConfig
+	// puts the previous settings back once it has read everything
 	go func() {
 		wg.Wait()
+		if Debug {
+			printDebug(fmt.Sprintf("milliseconds proessing files: %d", makeTimestampMilli()-startTime))
+		}
 		close(output)
 	}()
-
-	if Debug {
-		printDebug(fmt.Sprintf("milliseconds proessing files: %d", makeTimestampMilli()-startTime))
-	}
 }
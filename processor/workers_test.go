@@ -2,9 +2,16 @@ package processor
 
 import (
 	"bytes"
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
+	"runtime/debug"
 	"strings"
 	"testing"
+	"time"
+	"unicode/utf16"
 )
 
 func TestIsWhitespace(t *testing.T) {
@@ -262,6 +269,21 @@ func TestCountStatsBlankLines(t *testing.T) {
 	}
 }
 
+func TestCountStatsBlankLinesIndentation(t *testing.T) {
+	ProcessConstants()
+
+	// Every line other than the function is only whitespace so should be blank whatever it is indented with
+	fileJob := FileJob{
+		Language: "C",
+		Content:  []byte("int main() {\n\t\n\t\t\n  \t \n\t\r\n\f\n\v\t\n}\n"),
+	}
+
+	CountStats(&fileJob)
+	if fileJob.Lines != 8 || fileJob.Code != 2 || fileJob.Blank != 6 {
+		t.Errorf("Expected 8 lines 2 code 6 blank got %d %d %d", fileJob.Lines, fileJob.Code, fileJob.Blank)
+	}
+}
+
 func TestCountStatsComplexityCount(t *testing.T) {
 	ProcessConstants()
 	fileJob := FileJob{}
@@ -431,6 +453,40 @@ func TestCountStatsNestedComments(t *testing.T) {
 	}
 }
 
+func TestCountStatsNestedCommentsThreeLevels(t *testing.T) {
+	ProcessConstants()
+	fileJob := FileJob{
+		Language: "Rust",
+	}
+
+	fileJob.Content = []byte(`/* one
+/* two
+/* three */
+still two */
+still one */
+fn main() {} /* a /* b /* c */ b */
+a */
+fn other() {}`)
+
+	CountStats(&fileJob)
+
+	if fileJob.Lines != 8 {
+		t.Errorf("Expected 8 lines got %d", fileJob.Lines)
+	}
+
+	if fileJob.Code != 2 {
+		t.Errorf("Expected 2 lines got %d", fileJob.Code)
+	}
+
+	if fileJob.Comment != 6 {
+		t.Errorf("Expected 6 lines got %d", fileJob.Comment)
+	}
+
+	if fileJob.Blank != 0 {
+		t.Errorf("Expected 0 lines got %d", fileJob.Blank)
+	}
+}
+
 // Java does not support nested multiline comments
 func TestCountStatsNestedCommentsJava(t *testing.T) {
 	ProcessConstants()
@@ -684,6 +740,441 @@ t`)
 	}
 }
 
+func TestReadFileContent(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+	defer func() { MmapThreshold = 100 * 1024 * 1024 }()
+
+	name := filepath.Join(dir, "main.go")
+	ioutil.WriteFile(name, []byte("package main\n\nfunc main() {}\n"), 0600)
+
+	modTime := time.Date(2018, 3, 9, 10, 30, 0, 500, time.FixedZone("AEDT", 11*60*60))
+	os.Chtimes(name, modTime, modTime)
+
+	for _, threshold := range []int64{0, 1, 1024} {
+		MmapThreshold = threshold
+
+		fileJob := FileJob{Location: name, Language: "Go"}
+		if err := readFileContent(&fileJob); err != nil {
+			t.Fatalf("Expected no error got %s", err)
+		}
+
+		if string(fileJob.Content) != "package main\n\nfunc main() {}\n" {
+			t.Errorf("Unexpected content %q with threshold %d", fileJob.Content, threshold)
+		}
+
+		if got := fileJob.ModTime.Format(time.RFC3339Nano); got != "2018-03-08T23:30:00Z" {
+			t.Errorf("Expected 2018-03-08T23:30:00Z got %s", got)
+		}
+
+		CountStats(&fileJob)
+		releaseContent(&fileJob)
+
+		if fileJob.Code != 2 || fileJob.Content != nil || fileJob.unmap != nil {
+			t.Errorf("Expected 2 code lines with content released got %d with threshold %d", fileJob.Code, threshold)
+		}
+	}
+
+	if err := readFileContent(&FileJob{Location: filepath.Join(dir, "missing.go")}); err == nil {
+		t.Error("Expected error for missing file")
+	}
+}
+
+func TestFileReaderWorkerMaxFileSize(t *testing.T) {
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	MaxFileSize = 10
+	defer func() { MaxFileSize = 0 }()
+	skipped.Reset()
+	defer skipped.Reset()
+
+	ioutil.WriteFile(filepath.Join(dir, "small.go"), []byte("package a\n"), 0600)
+	ioutil.WriteFile(filepath.Join(dir, "large.go"), []byte("package main\n"), 0600)
+
+	input := make(chan *FileJob, 10)
+	output := make(chan *FileJob, 10)
+	input <- &FileJob{Language: "Go", Location: filepath.Join(dir, "small.go")}
+	input <- &FileJob{Language: "Go", Location: filepath.Join(dir, "large.go")}
+	close(input)
+
+	fileReaderWorker(context.Background(), input, output)
+
+	read := []string{}
+	for res := range output {
+		read = append(read, filepath.Base(res.Location))
+	}
+
+	if len(read) != 1 || read[0] != "small.go" {
+		t.Errorf("Expected only small.go to be read got %v", read)
+	}
+
+	if files := skipped.Files(); len(files) != 1 || files[0].Reason != SkipFileSize {
+		t.Errorf("Expected large.go to be skipped as over max file size got %v", files)
+	}
+}
+
+func TestCountStatsTokens(t *testing.T) {
+	ProcessConstants()
+	fileJob := FileJob{
+		Language: "Go",
+		Content: []byte(`// comment
+if a == "b" {
+	/* comment */
+}`),
+	}
+
+	CountStats(&fileJob)
+
+	// The comments, the if, the == and the string
+	if fileJob.Tokens != 5 {
+		t.Errorf("Expected 5 tokens got %d", fileJob.Tokens)
+	}
+}
+
+func TestCountLines(t *testing.T) {
+	for content, lines := range map[string]int64{"": 0, "a": 1, "a\n": 1, "a\nb": 2, "\n\n\n": 3} {
+		fileJob := FileJob{Content: []byte(content)}
+		countLines(&fileJob)
+
+		// Should always agree with the full count
+		counted := FileJob{Content: []byte(content)}
+		CountStats(&counted)
+
+		if fileJob.Lines != lines || counted.Lines != lines || fileJob.Bytes != int64(len(content)) {
+			t.Errorf("Expected %d lines for %q got %d", lines, content, fileJob.Lines)
+		}
+	}
+
+	fileJob := FileJob{Content: []byte("a\x00b\n")}
+	countLines(&fileJob)
+	if !fileJob.Binary {
+		t.Error("Expected binary")
+	}
+}
+
+func TestCountStatsHeredoc(t *testing.T) {
+	ProcessConstants()
+	fileJob := FileJob{
+		Language: "BASH",
+	}
+
+	fileJob.Content = []byte(`# comment
+cat <<EOF > out.txt # comment
+# not a comment
+EOF
+cat <<- 'END'
+	# not a comment
+	END
+# comment
+echo $((1 << 2))
+`)
+
+	CountStats(&fileJob)
+
+	if fileJob.Lines != 9 {
+		t.Errorf("Expected 9 lines got %d", fileJob.Lines)
+	}
+
+	if fileJob.Code != 7 {
+		t.Errorf("Expected 7 code lines got %d", fileJob.Code)
+	}
+
+	if fileJob.Comment != 2 {
+		t.Errorf("Expected 2 comment lines got %d", fileJob.Comment)
+	}
+}
+
+func TestCountStatsHeredocShift(t *testing.T) {
+	ProcessConstants()
+	fileJob := FileJob{
+		Language: "BASH",
+	}
+
+	fileJob.Content = []byte(`echo $((1 << n))
+# comment
+cat <<EOF
+	EOF
+# not a comment
+EOF
+# comment
+cat << EOF
+# not a comment
+EOF
+echo $((n<<m))
+# comment`)
+
+	CountStats(&fileJob)
+
+	if fileJob.Code != 9 {
+		t.Errorf("Expected 9 code lines got %d", fileJob.Code)
+	}
+
+	if fileJob.Comment != 3 {
+		t.Errorf("Expected 3 comment lines got %d", fileJob.Comment)
+	}
+}
+
+func TestCountStatsHeredocPHP(t *testing.T) {
+	ProcessConstants()
+	fileJob := FileJob{
+		Language: "PHP",
+	}
+
+	fileJob.Content = []byte(`<?php
+$text = <<<"EOT"
+// not a comment
+  EOTS
+  EOT;
+// comment`)
+
+	CountStats(&fileJob)
+
+	if fileJob.Code != 5 {
+		t.Errorf("Expected 5 code lines got %d", fileJob.Code)
+	}
+
+	if fileJob.Comment != 1 {
+		t.Errorf("Expected 1 comment lines got %d", fileJob.Comment)
+	}
+}
+
+func TestHeredocDelimiter(t *testing.T) {
+	cases := map[string]string{
+		"EOF":       "EOF",
+		" EOF":      "EOF",
+		" EOF > a":  "EOF",
+		"EOF|a":     "EOF",
+		" EOF; a":   "EOF",
+		" EOF 2>&1": "EOF",
+		" 'EOF'":    "EOF",
+		"'EOF' | a": "EOF",
+		`"EOF"`:     "EOF",
+		" n))":      "",
+		"n))":       "",
+		" n + 1))":  "",
+		"'EOF":      "",
+		" 2":        "",
+		"<EOF":      "",
+		"":          "",
+	}
+
+	for content, expected := range cases {
+		fileJob := FileJob{Content: []byte("<<" + content)}
+		_, delimiter := heredocDelimiter(&fileJob, 2, len(fileJob.Content)-1, []byte("<<"))
+		if string(delimiter) != expected {
+			t.Errorf("Expected %q for %q got %q", expected, content, delimiter)
+		}
+	}
+}
+
+func TestCountStatsVerbatimString(t *testing.T) {
+	ProcessConstants()
+	fileJob := FileJob{
+		Language: "C#",
+	}
+
+	// Without the prefix the backslash would escape the quote leaving the string open
+	fileJob.Content = []byte(`var path = @"C:\";
+// comment
+var text = @"
+// not a comment
+";`)
+
+	CountStats(&fileJob)
+
+	if fileJob.Code != 4 {
+		t.Errorf("Expected 4 code lines got %d", fileJob.Code)
+	}
+
+	if fileJob.Comment != 1 {
+		t.Errorf("Expected 1 comment lines got %d", fileJob.Comment)
+	}
+}
+
+func TestCountStatsVerbatimStringDoubledQuote(t *testing.T) {
+	ProcessConstants()
+	fileJob := FileJob{
+		Language: "C#",
+	}
+
+	// The doubled quote is a quote in the string rather than the end of it followed by
+	// a string where the backslash would escape the quote leaving it open
+	fileJob.Content = []byte(`var text = @"a""\";
+// comment
+var empty = @"";
+// comment`)
+
+	CountStats(&fileJob)
+
+	if fileJob.Code != 2 {
+		t.Errorf("Expected 2 code lines got %d", fileJob.Code)
+	}
+
+	if fileJob.Comment != 2 {
+		t.Errorf("Expected 2 comment lines got %d", fileJob.Comment)
+	}
+}
+
+func TestCountStatsVerbatimStringPython(t *testing.T) {
+	ProcessConstants()
+	fileJob := FileJob{
+		Language: "Python",
+	}
+
+	// A backslash keeps the quote after it from ending a raw string but not the one after \\
+	fileJob.Content = []byte(`text = r"""\"""
+# not a comment
+"""
+path = r"C:\\"
+# comment`)
+
+	CountStats(&fileJob)
+
+	if fileJob.Code != 4 {
+		t.Errorf("Expected 4 code lines got %d", fileJob.Code)
+	}
+
+	if fileJob.Comment != 1 {
+		t.Errorf("Expected 1 comment lines got %d", fileJob.Comment)
+	}
+}
+
+func TestCountStatsRawString(t *testing.T) {
+	ProcessConstants()
+	fileJob := FileJob{
+		Language: "C++",
+	}
+
+	fileJob.Content = []byte(`auto text = R"sql(
+/* not a comment )"
+// not a comment
+)sql";
+// comment
+auto other = LR"(\)";
+// comment`)
+
+	CountStats(&fileJob)
+
+	if fileJob.Code != 5 {
+		t.Errorf("Expected 5 code lines got %d", fileJob.Code)
+	}
+
+	if fileJob.Comment != 2 {
+		t.Errorf("Expected 2 comment lines got %d", fileJob.Comment)
+	}
+}
+
+func TestRawStringDelimiter(t *testing.T) {
+	cases := map[string]string{
+		`R"(`:                  `)"`,
+		`R"sql(`:               `)sql"`,
+		`R"sql`:                "",
+		`R"a b(`:               "",
+		`R"12345678901234567(`: "",
+	}
+
+	for content, expected := range cases {
+		fileJob := FileJob{Content: []byte(content)}
+		_, endString := rawStringDelimiter(&fileJob, 2, len(fileJob.Content)-1)
+		if string(endString) != expected {
+			t.Errorf("Expected %q for %q got %q", expected, content, endString)
+		}
+	}
+}
+
+func TestCountStatsDocstrings(t *testing.T) {
+	ProcessConstants()
+	defer func() { Docstrings = false }()
+
+	content := []byte(`"""Module docstring"""
+import os
+
+def run(path):
+    # comment
+    """
+    Runs the "path" # not a comment
+    """
+    text = """
+    not a docstring
+    """
+    return text
+`)
+
+	// Lines, code, comments with docstrings off and then on
+	for docstrings, expected := range map[bool][3]int64{false: {12, 10, 1}, true: {12, 6, 5}} {
+		Docstrings = docstrings
+		fileJob := FileJob{Language: "Python", Content: content}
+		CountStats(&fileJob)
+
+		if fileJob.Lines != expected[0] || fileJob.Code != expected[1] || fileJob.Comment != expected[2] {
+			t.Errorf("Expected %v with docstrings %v got %d %d %d", expected, docstrings, fileJob.Lines, fileJob.Code, fileJob.Comment)
+		}
+	}
+}
+
+func TestCountStatsImports(t *testing.T) {
+	ProcessConstants()
+	defer func() { Imports = false }()
+
+	tests := []struct {
+		language string
+		content  string
+		code     int64
+		imports  int64
+	}{
+		{"Go", "package main\n\nimport (\n\t\"fmt\"\n\n\t\"os\"\n)\n\nimport \"strings\"\n\nfunc main() {}\n", 2, 5},
+		{"Go", "package main\nimport (\"fmt\")\nvar x = 1\n", 2, 1},
+		{"Java", "package a;\n\nimport java.util.List;\nimport static java.lang.Math.max;\n\nclass A {}\n", 2, 2},
+		{"Python", "import os\nfrom sys import argv\n\nprint(argv)\n", 1, 2},
+		{"C", "#include <stdio.h>\nint main() {}\n", 2, 0},
+		{"Python", "def read():\n    text = r\"\"\"Reads lines\nfrom the input and\nimport it.\n    \"\"\"\n    return text\n\nimport os\n", 6, 1},
+		{"Go", "package main\n\nvar usage = `run it\nimport \"fmt\" first\n`\n", 4, 0},
+	}
+
+	for _, test := range tests {
+		for _, imports := range []bool{false, true} {
+			Imports = imports
+			fileJob := FileJob{Language: test.language, Content: []byte(test.content)}
+			CountStats(&fileJob)
+
+			code, count := test.code+test.imports, int64(0)
+			if imports {
+				code, count = test.code, test.imports
+			}
+
+			if fileJob.Code != code || fileJob.Imports != count {
+				t.Errorf("Expected %d %d for %s with imports %v got %d %d", code, count, test.language, imports, fileJob.Code, fileJob.Imports)
+			}
+		}
+	}
+}
+
+func TestIsTestFile(t *testing.T) {
+	ProcessConstants()
+
+	tests := []struct {
+		language string
+		filename string
+		expected bool
+	}{
+		{"Go", "main_test.go", true},
+		{"Go", "main.go", false},
+		{"Java", "ParserTest.java", true},
+		{"Java", "Parser.java", false},
+		{"Python", "test_parser.py", true},
+		{"Python", "parser.py", false},
+		{"TypeScript", "parser.spec.ts", true},
+		{"C", "test_parser.c", false},
+	}
+
+	for _, test := range tests {
+		if got := isTestFile(&FileJob{Language: test.language, Filename: test.filename}); got != test.expected {
+			t.Errorf("Expected %v for %s got %v", test.expected, test.filename, got)
+		}
+	}
+}
+
 //////////////////////////////////////////////////
 // Benchmarks Below
 //////////////////////////////////////////////////
@@ -1203,3 +1694,388 @@ func BenchmarkCheckLenPrecalc(b *testing.B) {
 
 	b.Log(count)
 }
+
+func TestWithinLineLimits(t *testing.T) {
+	MinLines, MaxLines = 2, 10
+	defer func() { MinLines, MaxLines = 0, 0 }()
+
+	if withinLineLimits(1) || !withinLineLimits(2) || !withinLineLimits(10) || withinLineLimits(11) {
+		t.Error("Expected only 2 to 10 lines to be within limits")
+	}
+
+	MaxLines = 0
+	if !withinLineLimits(1000000) {
+		t.Error("Expected no maximum when MaxLines is 0")
+	}
+}
+
+func TestFileProcessorWorkerLineLimits(t *testing.T) {
+	ProcessConstants()
+	MinLines = 2
+	defer func() { MinLines = 0 }()
+
+	input := make(chan *FileJob, 10)
+	output := make(chan *FileJob, 10)
+	input <- &FileJob{Language: "Go", Location: "one.go", Content: []byte("package main")}
+	input <- &FileJob{Language: "Go", Location: "two.go", Content: []byte("package main\n\nfunc main() {}")}
+	close(input)
+
+	fileProcessorWorker(context.Background(), input, output)
+
+	var locations []string
+	for res := range output {
+		locations = append(locations, res.Location)
+	}
+
+	if len(locations) != 1 || locations[0] != "two.go" {
+		t.Errorf("Expected only two.go got %v", locations)
+	}
+}
+
+// Files which were not found by walking a directory, such as those from stdin or --git-tracked,
+// still need to turn the GC back on
+func TestFileProcessorWorkerResetsGc(t *testing.T) {
+	ProcessConstants()
+	current := debug.SetGCPercent(-1)
+	defer debug.SetGCPercent(current)
+
+	gcPercent = 100
+	GcFileCount = 2
+	defer func() {
+		gcPercent = -1
+		GcFileCount = 10000
+	}()
+
+	input := make(chan *FileJob, 10)
+	output := make(chan *FileJob, 10)
+	input <- &FileJob{Language: "Go", Location: "one.go", Content: []byte("package main")}
+	input <- &FileJob{Language: "Go", Location: "two.go", Content: []byte("package main")}
+	close(input)
+
+	fileProcessorWorker(context.Background(), input, output)
+	for range output {
+	}
+
+	if percent := debug.SetGCPercent(-1); percent != 100 {
+		t.Errorf("Expected %v got %v", 100, percent)
+	}
+}
+
+func TestIsMinified(t *testing.T) {
+	if isMinified(&FileJob{Bytes: 1000, Lines: 10}) {
+		t.Error("Expected 100 bytes per line to not be minified")
+	}
+
+	if !isMinified(&FileJob{Bytes: 1000, Lines: 1}) {
+		t.Error("Expected 1000 bytes on a single line to be minified")
+	}
+
+	if isMinified(&FileJob{Bytes: 0, Lines: 0}) {
+		t.Error("Expected empty file to not be minified")
+	}
+}
+
+func TestMeasureLineLengths(t *testing.T) {
+	fileJob := &FileJob{Content: []byte("package main\r\n\nfunc main() {}\rvar héllo = 1")}
+	measureLineLengths(fileJob)
+
+	if fileJob.MaxLineLength != 14 {
+		t.Errorf("Expected 14 got %d", fileJob.MaxLineLength)
+	}
+
+	if fileJob.lineLengthLines != 4 || fileJob.lineLengthSum != 39 || fileJob.AverageLineLength != 9.75 {
+		t.Errorf("Expected 4 lines of 39 characters got %d %d %v", fileJob.lineLengthLines, fileJob.lineLengthSum, fileJob.AverageLineLength)
+	}
+
+	empty := &FileJob{}
+	measureLineLengths(empty)
+	if empty.MaxLineLength != 0 || empty.AverageLineLength != 0 {
+		t.Errorf("Expected no line lengths got %d %v", empty.MaxLineLength, empty.AverageLineLength)
+	}
+}
+
+func TestFileProcessorWorkerMinified(t *testing.T) {
+	ProcessConstants()
+	minified := []byte("var a=1;" + strings.Repeat("a=a+1;", 100))
+
+	process := func() map[string]string {
+		skipped.Reset()
+		input := make(chan *FileJob, 10)
+		output := make(chan *FileJob, 10)
+		input <- &FileJob{Language: "JavaScript", Location: "app.min.js", Content: minified}
+		input <- &FileJob{Language: "JavaScript", Location: "app.js", Content: []byte("var a = 1;\na = a + 1;\n")}
+		close(input)
+
+		fileProcessorWorker(context.Background(), input, output)
+
+		languages := map[string]string{}
+		for res := range output {
+			languages[res.Location] = res.Language
+		}
+		return languages
+	}
+
+	Minified = true
+	languages := process()
+	if languages["app.min.js"] != MinifiedLanguage || languages["app.js"] != "JavaScript" {
+		t.Errorf("Expected app.min.js to be counted as %s got %v", MinifiedLanguage, languages)
+	}
+	Minified = false
+
+	NoMinified = true
+	defer func() { NoMinified = false }()
+	languages = process()
+	if _, ok := languages["app.min.js"]; ok || len(languages) != 1 {
+		t.Errorf("Expected app.min.js to be skipped got %v", languages)
+	}
+
+	if files := skipped.Files(); len(files) != 1 || files[0].Reason != SkipMinified {
+		t.Errorf("Expected app.min.js to be skipped as minified got %v", files)
+	}
+}
+
+func TestDecodeContent(t *testing.T) {
+	source := "// comment\nint main() {\n  return 0; // ☃\n}\n"
+
+	utf16le := []byte{0xFF, 0xFE}
+	utf16be := []byte{0xFE, 0xFF}
+	for _, r := range utf16.Encode([]rune(source)) {
+		utf16le = append(utf16le, byte(r), byte(r>>8))
+		utf16be = append(utf16be, byte(r>>8), byte(r))
+	}
+
+	cases := map[string][]byte{
+		"utf-8":          []byte(source),
+		"utf-8 with bom": append([]byte{0xEF, 0xBB, 0xBF}, source...),
+		"utf-16le":       utf16le,
+		"utf-16be":       utf16be,
+		"utf-16le odd":   append(utf16le, 0x00),
+	}
+
+	for name, content := range cases {
+		if got := string(decodeContent(content)); got != source {
+			t.Errorf("Expected %q for %s got %q", source, name, got)
+		}
+	}
+}
+
+func TestFileReaderWorkerUTF16(t *testing.T) {
+	ProcessConstants()
+	dir, _ := ioutil.TempDir("", "scc-test")
+	defer os.RemoveAll(dir)
+
+	content := []byte{0xFF, 0xFE}
+	for _, r := range utf16.Encode([]rune("// comment\r\nint main() {\r\n\r\n  return 0;\r\n}\r\n")) {
+		content = append(content, byte(r), byte(r>>8))
+	}
+	name := filepath.Join(dir, "main.c")
+	ioutil.WriteFile(name, content, 0600)
+
+	input := make(chan *FileJob, 1)
+	output := make(chan *FileJob, 1)
+	input <- &FileJob{Location: name, Filename: "main.c", Language: "C"}
+	close(input)
+
+	fileReaderWorker(context.Background(), input, output)
+	res := <-output
+	CountStats(res)
+
+	if res.Binary || res.Lines != 5 || res.Code != 3 || res.Comment != 1 || res.Blank != 1 {
+		t.Errorf("Expected 5 lines with 3 code, 1 comment and 1 blank got %+v", res)
+	}
+}
+
+func TestCountStatsTrailingNewline(t *testing.T) {
+	ProcessConstants()
+
+	cases := []struct {
+		language string
+		content  string
+	}{
+		{"Go", "package main\n\nfunc main() {}"},
+		{"Go", "package main\n\n// comment"},
+		{"Go", "package main\n\n/* comment"},
+		{"Go", "package main\n\nvar a = `string"},
+		{"Python", "import os\n\nx = '''string"},
+		{"Shell", "echo\n\ncat <<EOF"},
+	}
+
+	for _, c := range cases {
+		without := FileJob{Language: c.language, Content: []byte(c.content)}
+		with := FileJob{Language: c.language, Content: []byte(c.content + "\n")}
+		CountStats(&without)
+		CountStats(&with)
+
+		if without.Lines != 3 || without.Lines != with.Lines || without.Code != with.Code || without.Comment != with.Comment || without.Blank != with.Blank {
+			t.Errorf("Expected 3 lines with and without a trailing newline for %q got %+v and %+v", c.content, without, with)
+		}
+
+		lines := FileJob{Language: c.language, Content: []byte(c.content)}
+		countLines(&lines)
+		if lines.Lines != 3 {
+			t.Errorf("Expected --lines-only to count 3 lines for %q got %d", c.content, lines.Lines)
+		}
+	}
+}
+
+func TestNormalizeLineEndings(t *testing.T) {
+	cases := map[string]string{
+		"":                "",
+		"a\nb\n":          "a\nb\n",
+		"a\r\nb\r\n":      "a\r\nb\r\n",
+		"a\rb\r":          "a\nb\n",
+		"a\r\nb\rc\r\r\n": "a\r\nb\nc\n\r\n",
+		"a\r":             "a\n",
+	}
+
+	for content, expected := range cases {
+		if got := string(normalizeLineEndings([]byte(content))); got != expected {
+			t.Errorf("Expected %q for %q got %q", expected, content, got)
+		}
+	}
+
+	content := []byte("a\r\nb\r\n")
+	if got := normalizeLineEndings(content); &got[0] != &content[0] {
+		t.Error("Expected content without a lone \\r to not be copied")
+	}
+}
+
+func TestCountStatsLineEndings(t *testing.T) {
+	ProcessConstants()
+
+	source := "package main\n\n// comment\nfunc main() {\n  /* one\n  two */\n}\n"
+	endings := map[string]string{
+		"LF":    source,
+		"CRLF":  strings.Replace(source, "\n", "\r\n", -1),
+		"CR":    strings.Replace(source, "\n", "\r", -1),
+		"mixed": "package main\r\n\r// comment\nfunc main() {\r  /* one\r\n  two */\n}\r",
+	}
+
+	for name, content := range endings {
+		job := FileJob{Language: "Go", Content: []byte(content)}
+		CountStats(&job)
+
+		if job.Lines != 7 || job.Code != 3 || job.Comment != 3 || job.Blank != 1 {
+			t.Errorf("Expected 7 lines with 3 code, 3 comment and 1 blank for %s got %d %d %d %d", name, job.Lines, job.Code, job.Comment, job.Blank)
+		}
+
+		lines := FileJob{Language: "Go", Content: []byte(content)}
+		countLines(&lines)
+		if lines.Lines != 7 {
+			t.Errorf("Expected --lines-only to count 7 lines for %s got %d", name, lines.Lines)
+		}
+	}
+}
+
+func TestIsGenerated(t *testing.T) {
+	ProcessConstants()
+	cases := []struct {
+		language string
+		content  string
+		expected bool
+	}{
+		{"Go", "// Code generated by protoc-gen-go. DO NOT EDIT.\npackage api\n", true},
+		{"Go", "package main\n\nfunc main() {}\n", false},
+		{"JavaScript", "/*\n * @generated by Relay\n */\n", true},
+		{"C#", "// <auto-generated>\n//     This code was generated by a tool.\n", true},
+		{"Go", strings.Repeat("\n", 20) + "// Code generated DO NOT EDIT.\n", false},
+		{"Go", "", false},
+		{"Go", "package main\n\nvar msg = \"do not edit\"\n", false},
+		{"Go", "package main\n\nvar msg = `\n// do not edit\n`\n", false},
+		{"Python", "# Automatically generated by SWIG\nimport os\n", true},
+		{"Python", "def f():\n    return \"@generated\"\n", false},
+	}
+
+	for _, c := range cases {
+		if got := isGenerated(&FileJob{Language: c.language, Content: []byte(c.content)}); got != c.expected {
+			t.Errorf("Expected %t for %q got %t", c.expected, c.content, got)
+		}
+	}
+}
+
+func TestFileProcessorWorkerNoGen(t *testing.T) {
+	ProcessConstants()
+	skipped.Reset()
+	NoGen = true
+	defer func() { NoGen = false }()
+
+	input := make(chan *FileJob, 10)
+	output := make(chan *FileJob, 10)
+	input <- &FileJob{Language: "Go", Location: "api.pb.go", Content: []byte("// Code generated by protoc-gen-go. DO NOT EDIT.\npackage api\n")}
+	input <- &FileJob{Language: "Go", Location: "main.go", Content: []byte("package main\n")}
+	close(input)
+
+	fileProcessorWorker(context.Background(), input, output)
+
+	got := []string{}
+	for res := range output {
+		got = append(got, res.Location)
+	}
+
+	if len(got) != 1 || got[0] != "main.go" {
+		t.Errorf("Expected only main.go got %v", got)
+	}
+
+	if files := skipped.Files(); len(files) != 1 || files[0].Reason != SkipGenerated {
+		t.Errorf("Expected api.pb.go to be skipped as generated got %v", files)
+	}
+}
+
+func TestCountStatsULOC(t *testing.T) {
+	ProcessConstants()
+	ULOC = true
+	defer func() { ULOC = false }()
+	defer uniqueLines.Reset()
+
+	first := FileJob{Language: "Go", Content: []byte("package main\n\n// comment\nfunc main() {\n}\n")}
+	second := FileJob{Language: "Go", Content: []byte("package other\n\n  func main() {\n  }")}
+	third := FileJob{Language: "Java", Content: []byte("package main\n")}
+
+	for _, job := range []*FileJob{&first, &second, &third} {
+		CountStats(job)
+		uniqueLines.Add(job)
+	}
+
+	if first.Code != 3 || len(first.codeLineHashes) != 0 {
+		t.Errorf("Expected 3 code lines with hashes released got %d %d", first.Code, len(first.codeLineHashes))
+	}
+
+	// package main, func main() {, } and package other with the indented lines matching
+	if uniqueLines.Language("Go") != 4 {
+		t.Errorf("Expected 4 got %d", uniqueLines.Language("Go"))
+	}
+
+	if uniqueLines.Language("Java") != 1 {
+		t.Errorf("Expected 1 got %d", uniqueLines.Language("Java"))
+	}
+
+	if uniqueLines.Total() != 4 {
+		t.Errorf("Expected 4 got %d", uniqueLines.Total())
+	}
+}
+
+func TestCountStatsDuplicateHash(t *testing.T) {
+	ProcessConstants()
+	Duplicates = true
+	defer func() {
+		Duplicates = false
+		DuplicateHash = "md5"
+	}()
+
+	for name, size := range map[string]int{"fnv": 16, "md5": 16, "sha256": 32} {
+		DuplicateHash = name
+
+		first := FileJob{Language: "Go", Content: []byte("package main\n")}
+		second := FileJob{Language: "Go", Content: []byte("package main\n")}
+		CountStats(&first)
+		CountStats(&second)
+
+		if len(first.Hash) != size {
+			t.Errorf("Expected %s hash of %d bytes got %d", name, size, len(first.Hash))
+		}
+
+		if !bytes.Equal(first.Hash, second.Hash) {
+			t.Errorf("Expected %s hashes of the same content to match", name)
+		}
+	}
+}